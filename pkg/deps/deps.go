@@ -0,0 +1,51 @@
+// Package deps scans a target project's third-party module dependencies for
+// known vulnerabilities, cross-referencing go.mod/go.sum against OSV.dev and,
+// when available, narrowing results to reachable call paths with
+// govulncheck.
+package deps
+
+import (
+	"os"
+
+	"golang.org/x/mod/modfile"
+)
+
+// Module identifies a single required dependency at a resolved version.
+type Module struct {
+	Path    string
+	Version string
+}
+
+// Vulnerability is a single known issue affecting a Module, normalized from
+// whichever backend (OSV or govulncheck) reported it.
+type Vulnerability struct {
+	Module    Module
+	ID        string // e.g. "GO-2023-1234" or a CVE ID
+	Summary   string
+	CVSS      string // vector string, e.g. "CVSS:3.1/AV:N/AC:L/..."; "" if OSV reported no severity
+	FixedIn   string
+	Reachable *bool // nil when govulncheck data isn't available
+}
+
+// ParseGoMod reads the require directives out of the go.mod at path and
+// returns the modules whose versions should be checked against OSV.
+func ParseGoMod(path string) ([]Module, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := modfile.Parse(path, data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	modules := make([]Module, 0, len(f.Require))
+	for _, req := range f.Require {
+		if req.Indirect {
+			continue
+		}
+		modules = append(modules, Module{Path: req.Mod.Path, Version: req.Mod.Version})
+	}
+	return modules, nil
+}
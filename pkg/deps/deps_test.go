@@ -0,0 +1,34 @@
+package deps
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseGoMod(t *testing.T) {
+	const gomod = `module example.com/widget
+
+go 1.21
+
+require (
+	github.com/direct/dep v1.2.3
+	github.com/indirect/dep v1.5.6 // indirect
+)
+`
+	dir := t.TempDir()
+	path := filepath.Join(dir, "go.mod")
+	if err := os.WriteFile(path, []byte(gomod), 0644); err != nil {
+		t.Fatalf("write fixture go.mod: %v", err)
+	}
+
+	modules, err := ParseGoMod(path)
+	if err != nil {
+		t.Fatalf("ParseGoMod: %v", err)
+	}
+
+	want := []Module{{Path: "github.com/direct/dep", Version: "v1.2.3"}}
+	if len(modules) != len(want) || modules[0] != want[0] {
+		t.Errorf("ParseGoMod = %v, want %v (indirect requires must be excluded)", modules, want)
+	}
+}
@@ -0,0 +1,72 @@
+package deps
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os/exec"
+)
+
+// ErrGovulncheckNotFound is returned when the govulncheck binary isn't on
+// PATH; callers should fall back to OSV-only results rather than treat this
+// as fatal.
+var ErrGovulncheckNotFound = errors.New("deps: govulncheck not found on PATH")
+
+// govulncheckFinding is the subset of govulncheck's `-json` output we care
+// about: which vulnerability IDs have a call path actually reachable from
+// the scanned module.
+type govulncheckFinding struct {
+	OSV struct {
+		ID string `json:"id"`
+	} `json:"osv"`
+	Trace []struct {
+		Function string `json:"function"`
+	} `json:"trace"`
+}
+
+// RunGovulncheck invokes `govulncheck -json ./...` in dir and returns the set
+// of vulnerability IDs it found to be reachable via the module's call graph.
+// It returns ErrGovulncheckNotFound if the binary isn't installed, which
+// callers should treat as "no reachability data available" rather than a
+// scan failure.
+func RunGovulncheck(dir string) (map[string]bool, error) {
+	if _, err := exec.LookPath("govulncheck"); err != nil {
+		return nil, ErrGovulncheckNotFound
+	}
+
+	cmd := exec.Command("govulncheck", "-json", "./...")
+	cmd.Dir = dir
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	// govulncheck exits non-zero when it finds vulnerabilities; that's
+	// expected output, not a failure to run, so the error is intentionally
+	// ignored here.
+	_ = cmd.Run()
+
+	reachable := map[string]bool{}
+	dec := json.NewDecoder(&out)
+	for dec.More() {
+		var entry struct {
+			Finding *govulncheckFinding `json:"finding"`
+		}
+		if err := dec.Decode(&entry); err != nil {
+			break
+		}
+		if entry.Finding != nil && len(entry.Finding.Trace) > 0 {
+			reachable[entry.Finding.OSV.ID] = true
+		}
+	}
+	return reachable, nil
+}
+
+// ApplyReachability annotates each Vulnerability in vulns with whether
+// govulncheck found it reachable, leaving Reachable nil for IDs it has no
+// opinion on (e.g. vulnerabilities outside its scanned call graph).
+func ApplyReachability(vulns []Vulnerability, reachable map[string]bool) {
+	for i := range vulns {
+		if ok, known := reachable[vulns[i].ID]; known {
+			v := ok
+			vulns[i].Reachable = &v
+		}
+	}
+}
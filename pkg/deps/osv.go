@@ -0,0 +1,187 @@
+package deps
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+const osvBatchURL = "https://api.osv.dev/v1/querybatch"
+
+// osvQuery is a single entry of an OSV querybatch request.
+type osvQuery struct {
+	Package struct {
+		Name      string `json:"name"`
+		Ecosystem string `json:"ecosystem"`
+	} `json:"package"`
+	Version string `json:"version"`
+}
+
+type osvBatchResponse struct {
+	Results []struct {
+		Vulns []struct {
+			ID       string `json:"id"`
+			Summary  string `json:"summary"`
+			Severity []struct {
+				Type  string `json:"type"`
+				Score string `json:"score"`
+			} `json:"severity"`
+			Affected []struct {
+				Ranges []struct {
+					Events []struct {
+						Fixed string `json:"fixed"`
+					} `json:"events"`
+				} `json:"ranges"`
+			} `json:"affected"`
+		} `json:"vulns"`
+	} `json:"results"`
+}
+
+// cvssSeverityPriority ranks OSV severity "type" values so pickCVSS can
+// prefer the newest CVSS version OSV reports when a vuln has more than one.
+var cvssSeverityPriority = map[string]int{
+	"CVSS_V4": 3,
+	"CVSS_V3": 2,
+	"CVSS_V2": 1,
+}
+
+// pickCVSS returns the vector string for the highest-priority CVSS entry in
+// severity, or "" if severity carries no CVSS score.
+func pickCVSS(severity []struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}) string {
+	best, bestRank := "", 0
+	for _, s := range severity {
+		if rank := cvssSeverityPriority[s.Type]; rank > bestRank {
+			best, bestRank = s.Score, rank
+		}
+	}
+	return best
+}
+
+// OSVClient queries OSV.dev for known vulnerabilities in Go modules,
+// caching each module@version response on disk to stay under OSV's rate
+// limits across repeated scans.
+type OSVClient struct {
+	CacheDir string
+	HTTP     *http.Client
+}
+
+// NewOSVClient returns a client that caches responses under cacheDir.
+func NewOSVClient(cacheDir string) *OSVClient {
+	return &OSVClient{CacheDir: cacheDir, HTTP: http.DefaultClient}
+}
+
+// Query reports known vulnerabilities for each of modules, using the on-disk
+// cache where possible and falling back to a single OSV querybatch call for
+// everything not yet cached.
+func (c *OSVClient) Query(modules []Module) ([]Vulnerability, error) {
+	var findings []Vulnerability
+	var uncached []Module
+
+	for _, m := range modules {
+		if cached, ok := c.readCache(m); ok {
+			findings = append(findings, cached...)
+			continue
+		}
+		uncached = append(uncached, m)
+	}
+
+	if len(uncached) == 0 {
+		return findings, nil
+	}
+
+	fresh, err := c.queryBatch(uncached)
+	if err != nil {
+		return findings, fmt.Errorf("deps: osv querybatch: %w", err)
+	}
+
+	for i, m := range uncached {
+		c.writeCache(m, fresh[i])
+		findings = append(findings, fresh[i]...)
+	}
+	return findings, nil
+}
+
+func (c *OSVClient) queryBatch(modules []Module) ([][]Vulnerability, error) {
+	queries := make([]osvQuery, len(modules))
+	for i, m := range modules {
+		queries[i].Package.Name = m.Path
+		queries[i].Package.Ecosystem = "Go"
+		queries[i].Version = m.Version
+	}
+
+	body, err := json.Marshal(struct {
+		Queries []osvQuery `json:"queries"`
+	}{queries})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Post(osvBatchURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("osv.dev returned %s", resp.Status)
+	}
+
+	var parsed osvBatchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, err
+	}
+
+	out := make([][]Vulnerability, len(modules))
+	for i, result := range parsed.Results {
+		for _, v := range result.Vulns {
+			vuln := Vulnerability{Module: modules[i], ID: v.ID, Summary: v.Summary, CVSS: pickCVSS(v.Severity)}
+			for _, affected := range v.Affected {
+				for _, r := range affected.Ranges {
+					for _, event := range r.Events {
+						if event.Fixed != "" {
+							vuln.FixedIn = event.Fixed
+						}
+					}
+				}
+			}
+			out[i] = append(out[i], vuln)
+		}
+	}
+	return out, nil
+}
+
+func (c *OSVClient) cachePath(m Module) string {
+	key := sha256.Sum256([]byte(m.Path + "@" + m.Version))
+	return filepath.Join(c.CacheDir, hex.EncodeToString(key[:])+".json")
+}
+
+func (c *OSVClient) readCache(m Module) ([]Vulnerability, bool) {
+	data, err := os.ReadFile(c.cachePath(m))
+	if err != nil {
+		return nil, false
+	}
+	var vulns []Vulnerability
+	if err := json.Unmarshal(data, &vulns); err != nil {
+		return nil, false
+	}
+	return vulns, true
+}
+
+func (c *OSVClient) writeCache(m Module, vulns []Vulnerability) {
+	if err := os.MkdirAll(c.CacheDir, 0700); err != nil {
+		return
+	}
+	data, err := json.Marshal(vulns)
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(c.cachePath(m), data, 0600)
+}
@@ -0,0 +1,43 @@
+package deps
+
+import "testing"
+
+func TestPickCVSS(t *testing.T) {
+	type sev = struct {
+		Type  string `json:"type"`
+		Score string `json:"score"`
+	}
+
+	tests := []struct {
+		name     string
+		severity []sev
+		want     string
+	}{
+		{
+			name: "prefers CVSS_V4 over CVSS_V3",
+			severity: []sev{
+				{Type: "CVSS_V3", Score: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"},
+				{Type: "CVSS_V4", Score: "CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N"},
+			},
+			want: "CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N",
+		},
+		{
+			name:     "single CVSS_V3 entry",
+			severity: []sev{{Type: "CVSS_V3", Score: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"}},
+			want:     "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+		},
+		{
+			name:     "no severity reported",
+			severity: nil,
+			want:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pickCVSS(tt.severity); got != tt.want {
+				t.Errorf("pickCVSS(%v) = %q, want %q", tt.severity, got, tt.want)
+			}
+		})
+	}
+}
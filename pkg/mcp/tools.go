@@ -0,0 +1,204 @@
+// Package mcp wires narsil-mcp's scanning packages up as tools exposed over
+// the Model Context Protocol.
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/doctordisrespect/narsil-mcp/pkg/deps"
+	"github.com/doctordisrespect/narsil-mcp/pkg/scanner"
+	"github.com/doctordisrespect/narsil-mcp/pkg/secrets"
+)
+
+// Tool is a single MCP tool: a name/description/schema triple plus the
+// handler that runs when a client calls it.
+type Tool struct {
+	Name        string
+	Description string
+	InputSchema map[string]any
+	Handler     func(args json.RawMessage) (any, error)
+}
+
+var tools []Tool
+
+// Register adds a Tool to the set this server advertises. Tools register
+// themselves from an init func in their own file.
+func Register(t Tool) {
+	tools = append(tools, t)
+}
+
+// All returns every registered Tool, in registration order.
+func All() []Tool {
+	return tools
+}
+
+func init() {
+	Register(scanDependenciesTool())
+	Register(scanSourceTool())
+}
+
+type scanDependenciesArgs struct {
+	ProjectDir string `json:"project_dir"`
+}
+
+// scanDependenciesFinding is the JSON shape returned to MCP clients for a
+// single vulnerable dependency.
+type scanDependenciesFinding struct {
+	Module    string `json:"module"`
+	Version   string `json:"version"`
+	VulnID    string `json:"vuln_id"`
+	Summary   string `json:"summary"`
+	CVSS      string `json:"cvss,omitempty"`
+	FixedIn   string `json:"fixed_in,omitempty"`
+	Reachable *bool  `json:"reachable,omitempty"`
+}
+
+// scanDependenciesTool implements the scan_dependencies MCP tool: it parses
+// the target project's go.mod, cross-references OSV.dev (cached on disk),
+// and narrows to reachable call paths with govulncheck when available.
+func scanDependenciesTool() Tool {
+	return Tool{
+		Name:        "scan_dependencies",
+		Description: "Scan a Go project's go.mod for third-party modules with known OSV vulnerabilities, with call-graph reachability when govulncheck is installed.",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"project_dir": map[string]any{
+					"type":        "string",
+					"description": "Path to the Go project to scan (must contain a go.mod).",
+				},
+			},
+			"required": []string{"project_dir"},
+		},
+		Handler: func(raw json.RawMessage) (any, error) {
+			var args scanDependenciesArgs
+			if err := json.Unmarshal(raw, &args); err != nil {
+				return nil, fmt.Errorf("scan_dependencies: invalid arguments: %w", err)
+			}
+
+			modules, err := deps.ParseGoMod(filepath.Join(args.ProjectDir, "go.mod"))
+			if err != nil {
+				return nil, fmt.Errorf("scan_dependencies: %w", err)
+			}
+
+			cacheDir := filepath.Join(args.ProjectDir, ".narsil-cache", "osv")
+			vulns, err := deps.NewOSVClient(cacheDir).Query(modules)
+			if err != nil {
+				return nil, fmt.Errorf("scan_dependencies: %w", err)
+			}
+
+			if reachable, err := deps.RunGovulncheck(args.ProjectDir); err == nil {
+				deps.ApplyReachability(vulns, reachable)
+			}
+
+			findings := make([]scanDependenciesFinding, len(vulns))
+			for i, v := range vulns {
+				findings[i] = scanDependenciesFinding{
+					Module:    v.Module.Path,
+					Version:   v.Module.Version,
+					VulnID:    v.ID,
+					Summary:   v.Summary,
+					CVSS:      v.CVSS,
+					FixedIn:   v.FixedIn,
+					Reachable: v.Reachable,
+				}
+			}
+			return findings, nil
+		},
+	}
+}
+
+type scanSourceArgs struct {
+	ProjectDir string `json:"project_dir"`
+}
+
+// scanSourceFinding is the JSON shape returned to MCP clients for a single
+// rules.Finding (GO-001 through GO-012).
+type scanSourceFinding struct {
+	RuleID      string `json:"rule_id"`
+	Severity    string `json:"severity"`
+	Message     string `json:"message"`
+	Remediation string `json:"remediation,omitempty"`
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+	Column      int    `json:"column,omitempty"`
+}
+
+// scanSourceSecret is the JSON shape returned to MCP clients for a single
+// secrets.Finding.
+type scanSourceSecret struct {
+	Kind    string `json:"kind"`
+	Path    string `json:"path"`
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+// scanSourceResult is the combined scan_source response: static analysis
+// findings from pkg/rules plus leaked-credential findings from pkg/secrets.
+type scanSourceResult struct {
+	Findings []scanSourceFinding `json:"findings"`
+	Secrets  []scanSourceSecret  `json:"secrets"`
+}
+
+// scanSourceTool implements the scan_source MCP tool: it walks a project's
+// Go source for the patterns in pkg/rules (GO-001 through GO-012) and scans
+// the rest of the tree for leaked credentials via pkg/secrets.
+func scanSourceTool() Tool {
+	return Tool{
+		Name:        "scan_source",
+		Description: "Scan a project's Go source for security-relevant code patterns (SQL injection, SSRF, weak crypto, etc.) and its non-Go files for leaked credentials.",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"project_dir": map[string]any{
+					"type":        "string",
+					"description": "Path to the project to scan.",
+				},
+			},
+			"required": []string{"project_dir"},
+		},
+		Handler: func(raw json.RawMessage) (any, error) {
+			var args scanSourceArgs
+			if err := json.Unmarshal(raw, &args); err != nil {
+				return nil, fmt.Errorf("scan_source: invalid arguments: %w", err)
+			}
+
+			ruleFindings, err := scanner.ScanDir(args.ProjectDir)
+			if err != nil {
+				return nil, fmt.Errorf("scan_source: %w", err)
+			}
+
+			secretFindings, err := secrets.ScanRepo(args.ProjectDir)
+			if err != nil {
+				return nil, fmt.Errorf("scan_source: %w", err)
+			}
+
+			result := scanSourceResult{
+				Findings: make([]scanSourceFinding, len(ruleFindings)),
+				Secrets:  make([]scanSourceSecret, len(secretFindings)),
+			}
+			for i, f := range ruleFindings {
+				result.Findings[i] = scanSourceFinding{
+					RuleID:      f.RuleID,
+					Severity:    string(f.Severity),
+					Message:     f.Message,
+					Remediation: f.Remediation,
+					File:        f.File,
+					Line:        f.Line,
+					Column:      f.Column,
+				}
+			}
+			for i, s := range secretFindings {
+				result.Secrets[i] = scanSourceSecret{
+					Kind:    s.Kind,
+					Path:    s.Path,
+					Line:    s.Line,
+					Message: s.Message,
+				}
+			}
+			return result, nil
+		},
+	}
+}
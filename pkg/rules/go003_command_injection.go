@@ -0,0 +1,134 @@
+package rules
+
+import "go/ast"
+
+func init() {
+	Register(&commandInjectionRule{})
+}
+
+// execSink describes a process-execution call: argIndex is the position of
+// the command/program-name argument, and pkgs are the import paths its
+// selector's package qualifier must resolve to for the call to actually be
+// that exec/syscall API, as opposed to an unrelated type's same-named
+// method (e.g. (*sql.DB).Exec shares a name with syscall.Exec).
+type execSink struct {
+	argIndex int
+	pkgs     []string
+}
+
+// execSinks are calls that execute a process, keyed by selector/function
+// name. Matching the name alone isn't enough - see resolvesToExecPackage -
+// since Exec/Command/StartProcess are common method names on unrelated
+// types (database/sql's *DB.Exec, for one).
+var execSinks = map[string]execSink{
+	"Command":        {0, []string{"os/exec"}},       // exec.Command(name, arg...)
+	"CommandContext": {1, []string{"os/exec"}},       // exec.CommandContext(ctx, name, arg...)
+	"Exec":           {0, []string{"syscall"}},       // syscall.Exec(argv0, argv, envv)
+	"StartProcess":   {0, []string{"syscall", "os"}}, // syscall.StartProcess / os.StartProcess(name, argv, attr)
+}
+
+// commandInjectionRule follows attacker-controlled input into process
+// execution APIs across the exec/syscall surface, per GO-003.
+type commandInjectionRule struct{}
+
+func (r *commandInjectionRule) ID() string { return "GO-003" }
+
+func (r *commandInjectionRule) Description() string {
+	return "command injection via attacker-controlled input reaching process execution"
+}
+
+func (r *commandInjectionRule) Check(ctx *Context) []Finding {
+	var findings []Finding
+
+	for _, fn := range Functions(ctx.File) {
+		taint := AnalyzeFunction(ctx, fn)
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch v := n.(type) {
+			case *ast.CallExpr:
+				if f := r.checkCall(ctx, v, taint); f != nil {
+					findings = append(findings, *f)
+				}
+			case *ast.AssignStmt:
+				if f := r.checkCmdArgsAssign(ctx, v, taint); f != nil {
+					findings = append(findings, *f)
+				}
+			}
+			return true
+		})
+	}
+
+	return findings
+}
+
+func (r *commandInjectionRule) checkCall(ctx *Context, call *ast.CallExpr, taint *FunctionTaint) *Finding {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+	sink, known := execSinks[sel.Sel.Name]
+	if !known || sink.argIndex >= len(call.Args) || !r.resolvesToExecPackage(ctx, sel.X, sink.pkgs) {
+		return nil
+	}
+
+	// exec.Command/CommandContext can also be tainted through any of their
+	// variadic arguments (e.g. Command("sh", "-c", tainted)), not just the
+	// program name.
+	for i := sink.argIndex; i < len(call.Args); i++ {
+		if taint.IsTainted(call.Args[i]) {
+			return r.finding(ctx, call)
+		}
+	}
+	return nil
+}
+
+// resolvesToExecPackage reports whether a call's package qualifier x
+// actually resolves to one of pkgs, the same class of check resolveImportPath
+// already provides for GO-006/GO-008 - it's what tells syscall.Exec and
+// os/exec's Command apart from an unrelated type's same-named method.
+func (r *commandInjectionRule) resolvesToExecPackage(ctx *Context, x ast.Expr, pkgs []string) bool {
+	pkgIdent, ok := x.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	resolved := resolveImportPath(ctx.File, pkgIdent.Name)
+	for _, pkg := range pkgs {
+		if resolved == pkg {
+			return true
+		}
+	}
+	return false
+}
+
+// checkCmdArgsAssign flags assignments to (*exec.Cmd).Args built from
+// tainted input, e.g. `cmd.Args = append(cmd.Args, userInput)`.
+func (r *commandInjectionRule) checkCmdArgsAssign(ctx *Context, assign *ast.AssignStmt, taint *FunctionTaint) *Finding {
+	for i, lhs := range assign.Lhs {
+		sel, ok := lhs.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Args" {
+			continue
+		}
+		if i < len(assign.Rhs) && taint.IsTainted(assign.Rhs[i]) {
+			return r.finding(ctx, assign)
+		}
+	}
+	return nil
+}
+
+// Note on the allowlist safe pattern: a typed enum (`type OSProgram uint64`
+// with iota values) dispatched through a switch to a fixed table of
+// exec.Command calls naturally produces no finding here, since none of its
+// arguments match a known taint source - there's nothing to special-case.
+func (r *commandInjectionRule) finding(ctx *Context, n ast.Node) *Finding {
+	line, col := ctx.Position(n)
+	return &Finding{
+		RuleID:   r.ID(),
+		Severity: SeverityCritical,
+		Message:  "attacker-controlled input reaches a process execution API",
+		Remediation: "dispatch through a fixed table of allowed programs keyed by a typed enum constant, " +
+			"never build the command or its arguments from unvalidated input",
+		File:   ctx.Filename,
+		Line:   line,
+		Column: col,
+	}
+}
@@ -0,0 +1,21 @@
+package rules
+
+import "testing"
+
+func TestCommandInjectionRule(t *testing.T) {
+	ctx := parseFixture(t)
+	findings := (&commandInjectionRule{}).Check(ctx)
+	lines := findingLines(findings)
+
+	for _, want := range []int{56, 63, 64, 70} {
+		if !lines[want] {
+			t.Errorf("expected GO-003 finding at line %d, got none", want)
+		}
+	}
+
+	for _, safe := range []int{85, 87, 96, 114, 115} {
+		if lines[safe] {
+			t.Errorf("unexpected GO-003 finding at line %d", safe)
+		}
+	}
+}
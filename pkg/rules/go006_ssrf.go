@@ -0,0 +1,333 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+func init() {
+	Register(&ssrfRule{})
+}
+
+// ssrfSink describes an outbound-request call that issues an HTTP request
+// from a URL/string argument: argIndex is the position of the URL argument
+// in the call, and pkg is the import path the call's receiver must resolve
+// to for the call to actually be the net/http (or net/http/httputil) API,
+// as opposed to an unrelated type's same-named method.
+type ssrfSink struct {
+	argIndex int
+	pkg      string
+}
+
+// ssrfSinks are calls that issue an outbound HTTP request, keyed by selector
+// name. Matching the name alone isn't enough - see resolvesToPackage - since
+// e.g. a cache's Get(key) shares a name with http.Get(url).
+var ssrfSinks = map[string]ssrfSink{
+	"Get":                       {0, "net/http"},          // http.Get(url)
+	"Post":                      {0, "net/http"},          // http.Post(url, ...)
+	"Head":                      {0, "net/http"},          // http.Head(url)
+	"PostForm":                  {0, "net/http"},          // http.PostForm(url, ...)
+	"NewRequest":                {1, "net/http"},          // http.NewRequest(method, url, body)
+	"NewRequestWithContext":     {2, "net/http"},          // http.NewRequestWithContext(ctx, method, url, body)
+	"Do":                        {-1, "net/http"},         // (*http.Client).Do(req) - URL lives on the request, not an arg
+	"NewSingleHostReverseProxy": {0, "net/http/httputil"}, // httputil.NewSingleHostReverseProxy(url)
+}
+
+// ssrfRule flags outbound HTTP requests whose destination URL is built from
+// attacker-controlled input (form values, query params, headers, or request
+// bodies), per GO-006.
+type ssrfRule struct{}
+
+func (r *ssrfRule) ID() string { return "GO-006" }
+
+func (r *ssrfRule) Description() string {
+	return "server-side request forgery via attacker-controlled request URL"
+}
+
+func (r *ssrfRule) Check(ctx *Context) []Finding {
+	var findings []Finding
+
+	for _, fn := range Functions(ctx.File) {
+		findings = append(findings, r.checkFunc(ctx, fn)...)
+	}
+
+	return findings
+}
+
+func (r *ssrfRule) checkFunc(ctx *Context, fn *ast.FuncDecl) []Finding {
+	var findings []Finding
+	taint := AnalyzeFunction(ctx, fn)
+	clients := collectHTTPClientLocals(ctx, fn)
+
+	r.walkBlock(fn.Body, false, func(call *ast.CallExpr, guarded bool) {
+		if guarded {
+			return
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return
+		}
+		sink, known := ssrfSinks[sel.Sel.Name]
+		if !known || !r.resolvesToPackage(ctx, clients, sel.X, sink.pkg) {
+			return
+		}
+		switch {
+		case sel.Sel.Name == "Do":
+			// (*http.Client).Do(req): the request was likely built a few
+			// lines earlier via http.NewRequest, which we already flag at
+			// construction time, so Do itself is only a finding when its
+			// argument expression directly embeds tainted input (e.g. an
+			// inline &http.Request{URL: ...}).
+			if len(call.Args) == 1 && taint.IsTainted(call.Args[0]) {
+				findings = append(findings, r.finding(ctx, call))
+			}
+		default:
+			if sink.argIndex < len(call.Args) && taint.IsTainted(call.Args[sink.argIndex]) {
+				findings = append(findings, r.finding(ctx, call))
+			}
+		}
+	})
+
+	return findings
+}
+
+// walkBlock walks block's statements in order, threading whether a
+// host-allowlist guard is in effect for each call it finds via onCall. The
+// guard can apply two ways: an `if u.Hostname() == allowed { ... }` body is
+// guarded only for statements nested inside it, but an early-return guard
+// clause - `if u.Hostname() != allowed { return }` - guards every statement
+// that follows it in the same block, since control only reaches them for
+// the allowed host.
+func (r *ssrfRule) walkBlock(block *ast.BlockStmt, guarded bool, onCall func(call *ast.CallExpr, guarded bool)) {
+	g := guarded
+	for _, stmt := range block.List {
+		switch s := stmt.(type) {
+		case *ast.IfStmt:
+			// A positive match (`u.Hostname() == allowed`) guards the if's
+			// own body. A negative match that exits (`!= allowed { return
+			// }`) guards everything AFTER the if in this block instead,
+			// since control only reaches it once the host does match.
+			positive := r.isHostnameComparison(s.Cond, token.EQL)
+			negative := r.isHostnameComparison(s.Cond, token.NEQ)
+			r.walkBlock(s.Body, g || positive, onCall)
+			if s.Else != nil {
+				r.walkElse(s.Else, g || negative, onCall)
+			}
+			if negative && blockTerminates(s.Body) {
+				g = true
+			}
+		case *ast.SwitchStmt:
+			r.walkSwitch(s, g, onCall)
+		default:
+			r.scanStmt(stmt, g, onCall)
+		}
+	}
+}
+
+func (r *ssrfRule) walkElse(stmt ast.Stmt, guarded bool, onCall func(call *ast.CallExpr, guarded bool)) {
+	switch v := stmt.(type) {
+	case *ast.BlockStmt:
+		r.walkBlock(v, guarded, onCall)
+	case *ast.IfStmt:
+		// else-if: reuse the IfStmt handling above by wrapping it as the
+		// sole statement of a synthetic block.
+		r.walkBlock(&ast.BlockStmt{List: []ast.Stmt{v}}, guarded, onCall)
+	}
+}
+
+func (r *ssrfRule) walkSwitch(s *ast.SwitchStmt, guarded bool, onCall func(call *ast.CallExpr, guarded bool)) {
+	hasHostnameTag := s.Tag != nil && r.isHostnameCall(s.Tag)
+	for _, stmt := range s.Body.List {
+		cc, ok := stmt.(*ast.CaseClause)
+		if !ok {
+			continue
+		}
+		// A case with no value list is `default`, which runs for every
+		// host that didn't match an explicit case above it - it isn't
+		// itself an allowlist check, so it inherits only the outer guard
+		// state, not the switch's hostname tag.
+		caseGuarded := guarded
+		if len(cc.List) > 0 {
+			if hasHostnameTag {
+				caseGuarded = true
+			}
+			for _, expr := range cc.List {
+				if r.isHostnameComparison(expr, token.EQL) {
+					caseGuarded = true
+				}
+			}
+		}
+		r.walkBlock(&ast.BlockStmt{List: cc.Body}, caseGuarded, onCall)
+	}
+}
+
+// scanStmt finds every call within a statement that walkBlock doesn't
+// already special-case, re-dispatching to walkBlock/walkSwitch for any
+// nested block it encounters (a for/range/select body, say) so guard
+// tracking still applies inside it.
+func (r *ssrfRule) scanStmt(stmt ast.Stmt, guarded bool, onCall func(call *ast.CallExpr, guarded bool)) {
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.BlockStmt:
+			r.walkBlock(v, guarded, onCall)
+			return false
+		case *ast.IfStmt:
+			r.walkBlock(&ast.BlockStmt{List: []ast.Stmt{v}}, guarded, onCall)
+			return false
+		case *ast.SwitchStmt:
+			r.walkSwitch(v, guarded, onCall)
+			return false
+		case *ast.FuncLit:
+			// A nested closure gets its own taint/guard scope; out of
+			// reach for this function-at-a-time, syntax-only pass.
+			return false
+		case *ast.CallExpr:
+			onCall(v, guarded)
+		}
+		return true
+	})
+}
+
+// blockTerminates reports whether body's last statement unconditionally
+// exits (return/break/continue/panic), the shape an early-return guard
+// clause needs before it can be trusted to protect the rest of its block.
+func blockTerminates(body *ast.BlockStmt) bool {
+	if len(body.List) == 0 {
+		return false
+	}
+	switch last := body.List[len(body.List)-1].(type) {
+	case *ast.ReturnStmt:
+		return true
+	case *ast.BranchStmt:
+		return last.Tok == token.BREAK || last.Tok == token.CONTINUE
+	case *ast.ExprStmt:
+		call, ok := last.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		ident, ok := call.Fun.(*ast.Ident)
+		return ok && ident.Name == "panic"
+	}
+	return false
+}
+
+// collectHTTPClientLocals maps each identifier known to hold an *http.Client
+// (or http.Client) to true: function parameters declared with that type, and
+// local variables assigned an `&http.Client{...}`/`http.Client{...}`
+// composite literal. This is what lets resolvesToPackage tell a call like
+// `client.Do(req)` apart from a call on some unrelated type that happens to
+// share the Do method name.
+func collectHTTPClientLocals(ctx *Context, fn *ast.FuncDecl) map[string]bool {
+	locals := map[string]bool{}
+
+	if fn.Type.Params != nil {
+		for _, field := range fn.Type.Params.List {
+			if !isHTTPClientType(ctx, field.Type) {
+				continue
+			}
+			for _, name := range field.Names {
+				locals[name.Name] = true
+			}
+		}
+	}
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok {
+			return true
+		}
+		for i, lhs := range assign.Lhs {
+			ident, ok := lhs.(*ast.Ident)
+			if !ok || i >= len(assign.Rhs) {
+				continue
+			}
+			if isHTTPClientLit(ctx, assign.Rhs[i]) {
+				locals[ident.Name] = true
+			} else {
+				delete(locals, ident.Name)
+			}
+		}
+		return true
+	})
+
+	return locals
+}
+
+func isHTTPClientLit(ctx *Context, expr ast.Expr) bool {
+	if u, ok := expr.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		expr = u.X
+	}
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return false
+	}
+	return isHTTPClientType(ctx, lit.Type)
+}
+
+// isHTTPClientType reports whether expr is net/http's Client type, spelled
+// either "http.Client" or "*http.Client".
+func isHTTPClientType(ctx *Context, expr ast.Expr) bool {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Client" {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && resolveImportPath(ctx.File, pkg.Name) == "net/http"
+}
+
+// resolvesToPackage reports whether a call's receiver expression x actually
+// resolves to pkg: either a direct package-qualified call (http.Get(...)),
+// a selector rooted at that package (http.DefaultClient.Do(...)), or - for
+// net/http specifically - an identifier known (via clients) to hold an
+// http.Client. This is what tells net/http's Get/Post/Do apart from an
+// unrelated type's same-named method, the same class of bug resolveImportPath
+// already fixed for math/rand vs crypto/rand in GO-008.
+func (r *ssrfRule) resolvesToPackage(ctx *Context, clients map[string]bool, x ast.Expr, pkg string) bool {
+	switch v := x.(type) {
+	case *ast.Ident:
+		if resolveImportPath(ctx.File, v.Name) == pkg {
+			return true
+		}
+		return pkg == "net/http" && clients[v.Name]
+	case *ast.SelectorExpr:
+		pkgIdent, ok := v.X.(*ast.Ident)
+		return ok && resolveImportPath(ctx.File, pkgIdent.Name) == pkg
+	}
+	return false
+}
+
+// isHostnameComparison reports whether expr is a `u.Hostname() <op> x` (or
+// `x <op> u.Hostname()`) comparison using exactly op.
+func (r *ssrfRule) isHostnameComparison(expr ast.Expr, op token.Token) bool {
+	bin, ok := expr.(*ast.BinaryExpr)
+	if !ok || bin.Op != op {
+		return false
+	}
+	return r.isHostnameCall(bin.X) || r.isHostnameCall(bin.Y)
+}
+
+func (r *ssrfRule) isHostnameCall(expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == "Hostname"
+}
+
+func (r *ssrfRule) finding(ctx *Context, call *ast.CallExpr) Finding {
+	line, col := ctx.Position(call)
+	return Finding{
+		RuleID:   r.ID(),
+		Severity: SeverityHigh,
+		Message:  "outbound HTTP request URL is derived from attacker-controlled input (SSRF)",
+		Remediation: "validate the destination against a host allowlist (compare u.Hostname(), not the raw string) " +
+			"before issuing the request, or route through a fixed, server-controlled URL",
+		File:   ctx.Filename,
+		Line:   line,
+		Column: col,
+	}
+}
@@ -0,0 +1,21 @@
+package rules
+
+import "testing"
+
+func TestSSRFRule(t *testing.T) {
+	ctx := parseFixture(t)
+	findings := (&ssrfRule{}).Check(ctx)
+	lines := findingLines(findings)
+
+	for _, want := range []int{141, 146, 147, 399, 425, 426, 439, 453} {
+		if !lines[want] {
+			t.Errorf("expected GO-006 finding at line %d, got none", want)
+		}
+	}
+
+	for _, safe := range []int{273, 392, 417} {
+		if lines[safe] {
+			t.Errorf("unexpected GO-006 finding at line %d", safe)
+		}
+	}
+}
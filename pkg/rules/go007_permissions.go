@@ -0,0 +1,146 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"strconv"
+)
+
+func init() {
+	Register(&permissionsRule{})
+}
+
+// permModeArg maps a permission-taking call's selector name to the argument
+// index of its os.FileMode/int mode, whether the call targets a directory
+// (which needs the owner exec bit set to be usable at all), and the import
+// paths its selector's package qualifier must resolve to for the call to
+// actually be that os API, as opposed to an unrelated type's same-named
+// method (e.g. a cache's Mkdir sharing a name with os.Mkdir).
+type permSink struct {
+	argIndex int
+	isDir    bool
+	pkgs     []string
+}
+
+var permSinks = map[string]permSink{
+	"OpenFile":  {2, false, []string{"os"}},              // os.OpenFile(name, flag, perm)
+	"Chmod":     {1, false, []string{"os"}},              // os.Chmod(name, mode)
+	"Mkdir":     {1, true, []string{"os"}},               // os.Mkdir(name, perm)
+	"MkdirAll":  {1, true, []string{"os"}},               // os.MkdirAll(path, perm)
+	"WriteFile": {2, false, []string{"os", "io/ioutil"}}, // os.WriteFile(name, data, perm) / ioutil.WriteFile
+}
+
+// permissionsRule flags file and directory permission modes that grant group
+// or world access, per GO-007.
+type permissionsRule struct{}
+
+func (r *permissionsRule) ID() string { return "GO-007" }
+
+func (r *permissionsRule) Description() string {
+	return "insecure default file or directory permissions"
+}
+
+func (r *permissionsRule) Check(ctx *Context) []Finding {
+	var findings []Finding
+
+	ast.Inspect(ctx.File, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		sink, known := permSinks[sel.Sel.Name]
+		if !known || sink.argIndex >= len(call.Args) || !r.resolvesToPermPackage(ctx, sel.X, sink.pkgs) {
+			return true
+		}
+
+		mode, ok := modeLiteral(call.Args[sink.argIndex])
+		if !ok {
+			return true
+		}
+
+		if f := r.check(ctx, call, sink, mode); f != nil {
+			findings = append(findings, *f)
+		}
+		return true
+	})
+
+	return findings
+}
+
+// resolvesToPermPackage reports whether a call's package qualifier x
+// actually resolves to one of pkgs, the same resolveImportPath check GO-003
+// uses to tell os.Mkdir/os.Chmod/etc. apart from an unrelated type's
+// same-named method.
+func (r *permissionsRule) resolvesToPermPackage(ctx *Context, x ast.Expr, pkgs []string) bool {
+	pkgIdent, ok := x.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	resolved := resolveImportPath(ctx.File, pkgIdent.Name)
+	for _, pkg := range pkgs {
+		if resolved == pkg {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *permissionsRule) check(ctx *Context, call *ast.CallExpr, sink permSink, mode int64) *Finding {
+	if sink.isDir {
+		// A directory mode needs the owner exec bit or it's unusable (can't
+		// be entered), and must not grant group/world access beyond 0700.
+		if mode&0100 == 0 {
+			return r.finding(ctx, call, "directory mode %#o is unusable: owner execute bit is required to enter the directory", mode, "0700")
+		}
+		if mode&0077 != 0 {
+			return r.finding(ctx, call, "directory mode %#o grants group or world access", mode, "0700")
+		}
+		return nil
+	}
+
+	if mode&0077 != 0 {
+		return r.finding(ctx, call, "file mode %#o grants group or world access", mode, "0600")
+	}
+	return nil
+}
+
+func (r *permissionsRule) finding(ctx *Context, call *ast.CallExpr, format string, mode int64, suggested string) *Finding {
+	line, col := ctx.Position(call)
+	return &Finding{
+		RuleID:      r.ID(),
+		Severity:    SeverityMedium,
+		Message:     fmt.Sprintf(format, mode),
+		Remediation: fmt.Sprintf("use %s, the narrowest mode that still grants the owner the access they need", suggested),
+		File:        ctx.Filename,
+		Line:        line,
+		Column:      col,
+	}
+}
+
+// modeLiteral extracts an integer value from an octal literal (0644) or a
+// named os.FileMode constant (os.ModePerm). Unrecognized expressions (e.g. a
+// variable) return ok=false rather than a false positive.
+func modeLiteral(expr ast.Expr) (int64, bool) {
+	switch v := expr.(type) {
+	case *ast.BasicLit:
+		n, err := strconv.ParseInt(v.Value, 0, 64)
+		if err != nil {
+			return 0, false
+		}
+		return n, true
+	case *ast.SelectorExpr:
+		pkg, ok := v.X.(*ast.Ident)
+		if !ok || pkg.Name != "os" {
+			return 0, false
+		}
+		switch v.Sel.Name {
+		case "ModePerm":
+			return 0777, true
+		}
+	}
+	return 0, false
+}
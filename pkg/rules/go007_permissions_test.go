@@ -0,0 +1,27 @@
+package rules
+
+import "testing"
+
+func TestPermissionsRule(t *testing.T) {
+	ctx := parseFixture(t)
+	findings := (&permissionsRule{}).Check(ctx)
+	lines := findingLines(findings)
+
+	for _, want := range []int{152, 153, 154, 158, 159} {
+		if !lines[want] {
+			t.Errorf("expected GO-007 finding at line %d, got none", want)
+		}
+	}
+
+	for _, safe := range []int{279, 280} {
+		if lines[safe] {
+			t.Errorf("safeWriteConfig: unexpected GO-007 finding at line %d", safe)
+		}
+	}
+
+	for _, safe := range []int{171, 172} {
+		if lines[safe] {
+			t.Errorf("useUnrelatedCache: unexpected GO-007 finding at line %d (permCache.Mkdir/Chmod aren't os.Mkdir/os.Chmod)", safe)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package rules
+
+import (
+	"go/ast"
+	"path"
+	"regexp"
+	"strconv"
+)
+
+func init() {
+	Register(&weakRandomRule{})
+}
+
+// mathRandCalls are math/rand functions/methods whose output is
+// cryptographically weak.
+var mathRandCalls = map[string]bool{
+	"Int":       true,
+	"Int31":     true,
+	"Int63":     true,
+	"Intn":      true,
+	"Int63n":    true,
+	"Read":      true,
+	"NewSource": true,
+}
+
+// securitySensitiveName matches variable/parameter names that suggest the
+// value is used as a token, session ID, key, or other security-sensitive
+// secret, per GO-008.
+var securitySensitiveName = regexp.MustCompile(`(?i)(token|nonce|secret|salt|session|csrf|key|iv)`)
+
+// weakRandomRule flags math/rand output flowing into a variable whose name
+// suggests a security-sensitive use, or directly into a Set-Cookie header.
+type weakRandomRule struct{}
+
+func (r *weakRandomRule) ID() string { return "GO-008" }
+
+func (r *weakRandomRule) Description() string {
+	return "math/rand used to generate a security-sensitive value; use crypto/rand instead"
+}
+
+func (r *weakRandomRule) Check(ctx *Context) []Finding {
+	if isTestFile(ctx.Filename) {
+		return nil
+	}
+
+	var findings []Finding
+
+	ast.Inspect(ctx.File, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.AssignStmt:
+			for i, rhs := range v.Rhs {
+				if !r.isMathRandCall(ctx, rhs) || i >= len(v.Lhs) {
+					continue
+				}
+				if r.nameLooksSensitive(v.Lhs[i]) {
+					findings = append(findings, r.finding(ctx, v))
+				}
+			}
+		case *ast.CallExpr:
+			if r.isSetCookie(v) {
+				for _, arg := range v.Args {
+					if r.isMathRandCall(ctx, arg) {
+						findings = append(findings, r.finding(ctx, v))
+					}
+				}
+			}
+		}
+		return true
+	})
+
+	return findings
+}
+
+func (r *weakRandomRule) isMathRandCall(ctx *Context, expr ast.Expr) bool {
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		if ok && resolveImportPath(ctx.File, pkg.Name) == "math/rand" && mathRandCalls[sel.Sel.Name] {
+			found = true
+		}
+		return true
+	})
+	return found
+}
+
+// resolveImportPath returns the import path bound to alias within file,
+// resolving both explicit aliases (`mathrand "math/rand"`) and the
+// language's default package name (the last path element) for plain
+// imports. This is what tells "rand" apart from crypto/rand and math/rand
+// when either can be imported under that same default name.
+func resolveImportPath(file *ast.File, alias string) string {
+	for _, imp := range file.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		if imp.Name != nil {
+			if imp.Name.Name == alias {
+				return importPath
+			}
+			continue
+		}
+		if path.Base(importPath) == alias {
+			return importPath
+		}
+	}
+	return ""
+}
+
+func (r *weakRandomRule) nameLooksSensitive(lhs ast.Expr) bool {
+	ident, ok := lhs.(*ast.Ident)
+	return ok && securitySensitiveName.MatchString(ident.Name)
+}
+
+// isSetCookie recognizes w.Header().Set("Set-Cookie", ...) and the
+// equivalent http.Cookie{Value: ...} construction.
+func (r *weakRandomRule) isSetCookie(call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Set" || len(call.Args) == 0 {
+		return false
+	}
+	lit, ok := call.Args[0].(*ast.BasicLit)
+	return ok && lit.Value == `"Set-Cookie"`
+}
+
+func (r *weakRandomRule) finding(ctx *Context, n ast.Node) Finding {
+	line, col := ctx.Position(n)
+	return Finding{
+		RuleID:      r.ID(),
+		Severity:    SeverityHigh,
+		Message:     "math/rand output is used for a security-sensitive value (token/session/key)",
+		Remediation: "use crypto/rand instead - math/rand is predictable given its seed",
+		File:        ctx.Filename,
+		Line:        line,
+		Column:      col,
+	}
+}
+
+// isTestFile is the rule's safe-pattern check for math/rand used only in
+// test files, where determinism is often desired and there's no security
+// boundary to cross.
+func isTestFile(filename string) bool {
+	return len(filename) > len("_test.go") && filename[len(filename)-len("_test.go"):] == "_test.go"
+}
@@ -0,0 +1,54 @@
+package rules
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestWeakRandomRule(t *testing.T) {
+	ctx := parseFixture(t)
+	findings := (&weakRandomRule{}).Check(ctx)
+	lines := findingLines(findings)
+
+	for _, want := range []int{188, 193} {
+		if !lines[want] {
+			t.Errorf("expected GO-008 finding at line %d, got none", want)
+		}
+	}
+
+	if lines[293] {
+		t.Errorf("retryDelay: unexpected GO-008 finding at line 293 (non-sensitive jitter)")
+	}
+}
+
+// TestWeakRandomRule_CryptoRandAliasedAsRand guards against matching on the
+// bare identifier "rand" regardless of which package it's bound to:
+// crypto/rand is commonly imported under that same default name, and its
+// output is exactly what this rule wants callers to use instead of
+// math/rand.
+func TestWeakRandomRule_CryptoRandAliasedAsRand(t *testing.T) {
+	const src = `package main
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+func newSessionToken() string {
+	sessionToken := fmt.Sprintf("%d", rand.Int63())
+	return sessionToken
+}
+`
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "session.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("parse source: %v", err)
+	}
+	ctx := &Context{Fset: fset, File: file, Filename: "session.go"}
+
+	findings := (&weakRandomRule{}).Check(ctx)
+	if len(findings) != 0 {
+		t.Errorf("crypto/rand aliased as \"rand\": unexpected GO-008 findings %v", findings)
+	}
+}
@@ -0,0 +1,278 @@
+package rules
+
+import (
+	"go/ast"
+	"math"
+	"regexp"
+)
+
+func init() {
+	Register(&hardcodedCredentialsRule{})
+}
+
+// credentialNamePattern matches identifiers that suggest the value they're
+// assigned is a secret.
+var credentialNamePattern = regexp.MustCompile(`(?i)^(password|secret|token|apiKey|api_key|awsSecretKey|aws_secret_key)$`)
+
+// basicAuthSinks are selector names whose string-literal argument at
+// argIndex is a credential, even when the receiving variable isn't named
+// suggestively.
+var basicAuthSinks = map[string]int{
+	"Open":         1, // sql.Open(driver, dsn) - the DSN embeds user:pass
+	"PlainAuth":    2, // smtp.PlainAuth(identity, username, password, host)
+	"SetBasicAuth": 1, // (*http.Request).SetBasicAuth(username, password)
+}
+
+const (
+	minEntropyLen = 20
+
+	// hexEntropyThreshold and base64EntropyThreshold are per-alphabet: a
+	// fixed score can't work across both, since hex's 16-symbol alphabet
+	// caps Shannon entropy at log2(16) = 4.0 bits/char, well below what a
+	// threshold tuned for base64 (alphabet of 64, cap log2(64) = 6.0)
+	// needs to reject English prose. Random hex typically scores
+	// 3.2-4.0; random base64 typically scores 4.5-6.0.
+	hexEntropyThreshold    = 3.0
+	base64EntropyThreshold = 4.5
+)
+
+// hardcodedCredentialsRule flags string literals that are plausibly
+// credentials: either assigned to a suggestively-named variable, passed to a
+// known auth sink, or simply high-entropy enough to be a base64/hex secret,
+// per GO-009.
+type hardcodedCredentialsRule struct{}
+
+func (r *hardcodedCredentialsRule) ID() string { return "GO-009" }
+
+func (r *hardcodedCredentialsRule) Description() string {
+	return "hardcoded credential or high-entropy secret literal"
+}
+
+func (r *hardcodedCredentialsRule) Check(ctx *Context) []Finding {
+	var findings []Finding
+
+	ast.Inspect(ctx.File, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.AssignStmt:
+			findings = append(findings, r.checkAssign(ctx, v)...)
+		case *ast.ValueSpec:
+			findings = append(findings, r.checkValueSpec(ctx, v)...)
+		}
+		return true
+	})
+
+	// Calls inside a top-level function body are handled below with that
+	// function's locals in scope, so package-level initializers (which
+	// can't contain a ":=" local, but can embed a sink call directly,
+	// e.g. `var db, _ = sql.Open(driver, "user:pass@...")`) are walked
+	// separately here with no locals to resolve against.
+	for _, decl := range ctx.File.Decls {
+		if _, isFunc := decl.(*ast.FuncDecl); isFunc {
+			continue
+		}
+		ast.Inspect(decl, func(n ast.Node) bool {
+			if call, ok := n.(*ast.CallExpr); ok {
+				findings = append(findings, r.checkCall(ctx, call, nil)...)
+			}
+			return true
+		})
+	}
+
+	for _, fn := range Functions(ctx.File) {
+		locals := collectStringLiteralLocals(fn.Body)
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if call, ok := n.(*ast.CallExpr); ok {
+				findings = append(findings, r.checkCall(ctx, call, locals)...)
+			}
+			return true
+		})
+	}
+
+	return findings
+}
+
+// collectStringLiteralLocals maps each local variable currently holding a
+// bare string literal (`dsn := "postgres://..."`) to that literal, so
+// checkCall can resolve the idiomatic "build the DSN in a variable, then
+// pass it to sql.Open" pattern back to the literal it came from. A later
+// assignment to the same name clears the entry rather than leaving the
+// stale literal behind, so e.g. `dsn := "placeholder"; dsn =
+// os.Getenv("DSN")` isn't mistaken for a hardcoded value.
+func collectStringLiteralLocals(body ast.Node) map[string]*ast.BasicLit {
+	locals := map[string]*ast.BasicLit{}
+	ast.Inspect(body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok {
+			return true
+		}
+		for i, lhs := range assign.Lhs {
+			ident, ok := lhs.(*ast.Ident)
+			if !ok || i >= len(assign.Rhs) {
+				continue
+			}
+			if lit, ok := assign.Rhs[i].(*ast.BasicLit); ok {
+				locals[ident.Name] = lit
+			} else {
+				delete(locals, ident.Name)
+			}
+		}
+		return true
+	})
+	return locals
+}
+
+func (r *hardcodedCredentialsRule) checkAssign(ctx *Context, assign *ast.AssignStmt) []Finding {
+	var findings []Finding
+	for i, lhs := range assign.Lhs {
+		ident, ok := lhs.(*ast.Ident)
+		if !ok || i >= len(assign.Rhs) {
+			continue
+		}
+		lit, ok := assign.Rhs[i].(*ast.BasicLit)
+		if !ok {
+			continue
+		}
+		if credentialNamePattern.MatchString(ident.Name) {
+			findings = append(findings, r.finding(ctx, lit, "variable name suggests a hardcoded credential"))
+		} else if looksHighEntropy(lit.Value) {
+			findings = append(findings, r.finding(ctx, lit, "high-entropy string literal resembles an embedded secret"))
+		}
+	}
+	return findings
+}
+
+func (r *hardcodedCredentialsRule) checkValueSpec(ctx *Context, spec *ast.ValueSpec) []Finding {
+	var findings []Finding
+	for i, name := range spec.Names {
+		if i >= len(spec.Values) {
+			continue
+		}
+		lit, ok := spec.Values[i].(*ast.BasicLit)
+		if !ok || !credentialNamePattern.MatchString(name.Name) {
+			continue
+		}
+		findings = append(findings, r.finding(ctx, lit, "variable name suggests a hardcoded credential"))
+	}
+	return findings
+}
+
+func (r *hardcodedCredentialsRule) checkCall(ctx *Context, call *ast.CallExpr, locals map[string]*ast.BasicLit) []Finding {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+	argIndex, known := basicAuthSinks[sel.Sel.Name]
+	if !known || argIndex >= len(call.Args) {
+		return nil
+	}
+	lit, ok := resolveStringLiteral(call.Args[argIndex], locals)
+	if !ok {
+		return nil
+	}
+	return []Finding{r.finding(ctx, lit, "credential passed as a literal to an authentication API")}
+}
+
+// resolveStringLiteral returns the BasicLit expr evaluates to: expr itself
+// when it's an inlined literal, or the literal found at expr's assignment
+// when expr is a bare identifier referring to a local built a few lines
+// earlier (the `dsn := "..."; sql.Open("postgres", dsn)` form).
+func resolveStringLiteral(expr ast.Expr, locals map[string]*ast.BasicLit) (*ast.BasicLit, bool) {
+	switch v := expr.(type) {
+	case *ast.BasicLit:
+		return v, true
+	case *ast.Ident:
+		lit, ok := locals[v.Name]
+		return lit, ok
+	}
+	return nil, false
+}
+
+func (r *hardcodedCredentialsRule) finding(ctx *Context, n ast.Node, message string) Finding {
+	line, col := ctx.Position(n)
+	return Finding{
+		RuleID:      r.ID(),
+		Severity:    SeverityCritical,
+		Message:     message,
+		Remediation: "load credentials from environment variables or a secrets manager, never a source literal",
+		File:        ctx.Filename,
+		Line:        line,
+		Column:      col,
+	}
+}
+
+// looksHighEntropy reports whether a quoted string literal is long and
+// random-looking enough to be a base64/hex-encoded key, independent of its
+// variable name. The threshold applied depends on the literal's alphabet:
+// a hex string can never reach a base64-tuned score, since its 16-symbol
+// alphabet caps entropy at 4.0 bits/char.
+func looksHighEntropy(literal string) bool {
+	s := literal
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '`') {
+		s = s[1 : len(s)-1]
+	}
+	if len(s) < minEntropyLen {
+		return false
+	}
+	switch {
+	case isHexAlphabet(s):
+		return shannonEntropy(s) > hexEntropyThreshold
+	case isBase64Alphabet(s):
+		return shannonEntropy(s) > base64EntropyThreshold
+	default:
+		return false
+	}
+}
+
+// isHexAlphabet reports whether s consists entirely of hex digits and
+// contains at least one hex letter (a-f/A-F). The letter requirement
+// excludes purely numeric strings (order IDs, timestamps, phone numbers)
+// from the hex path: those are digits-only, so isBase64Alphabet still
+// classifies them, but at base64EntropyThreshold, which their low-entropy
+// decimal digits can't reach.
+func isHexAlphabet(s string) bool {
+	hasLetter := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+		case c >= 'a' && c <= 'f', c >= 'A' && c <= 'F':
+			hasLetter = true
+		default:
+			return false
+		}
+	}
+	return hasLetter
+}
+
+// isBase64Alphabet reports whether s consists entirely of standard or
+// URL-safe base64 characters (including the '=' padding character).
+func isBase64Alphabet(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case c == '+' || c == '/' || c == '=' || c == '-' || c == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func shannonEntropy(s string) float64 {
+	var counts [256]int
+	for i := 0; i < len(s); i++ {
+		counts[s[i]]++
+	}
+
+	var entropy float64
+	n := float64(len(s))
+	for _, c := range counts {
+		if c == 0 {
+			continue
+		}
+		p := float64(c) / n
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
@@ -0,0 +1,33 @@
+package rules
+
+import "testing"
+
+func TestHardcodedCredentialsRule(t *testing.T) {
+	ctx := parseFixture(t)
+	findings := (&hardcodedCredentialsRule{}).Check(ctx)
+	lines := findingLines(findings)
+
+	for _, want := range []int{198, 199, 204, 331, 366} {
+		if !lines[want] {
+			t.Errorf("expected GO-009 finding at line %d, got none", want)
+		}
+	}
+
+	for _, safe := range []int{371, 372, 376} {
+		if lines[safe] {
+			t.Errorf("unexpected GO-009 finding at line %d", safe)
+		}
+	}
+}
+
+func TestLooksHighEntropy(t *testing.T) {
+	if !looksHighEntropy(`"4f9b2c6a8e1d03f7b5c2a9e6d4f810b3"`) {
+		t.Error("expected a random-looking 32-char hex literal to be flagged as high entropy")
+	}
+	if looksHighEntropy(`"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"`) {
+		t.Error("did not expect a low-entropy repeated-character literal to be flagged")
+	}
+	if looksHighEntropy(`"20260115093045678912"`) {
+		t.Error("did not expect a digit-only string (order ID, timestamp) to be flagged as a hex secret")
+	}
+}
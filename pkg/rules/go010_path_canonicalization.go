@@ -0,0 +1,143 @@
+package rules
+
+import "go/ast"
+
+func init() {
+	Register(&pathCanonicalizationRule{})
+}
+
+// pathComparisonFuncs are calls whose first argument, when it is r.URL.Path,
+// indicate the result is being used to make an authorization decision.
+var pathComparisonFuncs = map[string]bool{
+	"HasPrefix": true, // strings.HasPrefix(r.URL.Path, ...)
+	"HasSuffix": true, // strings.HasSuffix(r.URL.Path, ...)
+	"Join":      true, // filepath.Join("/protected", r.URL.Path)
+}
+
+// pathCanonicalizationRule flags handlers that branch on r.URL.Path without
+// first normalizing it with path.Clean. net/http's ServeMux canonicalizes
+// paths for ordinary methods but not for CONNECT, so a raw comparison can be
+// bypassed with e.g. `curl --path-as-is -X CONNECT /../admin`, per GO-010.
+//
+// This flags any func(http.ResponseWriter, *http.Request)-shaped top-level
+// function (looksLikeHandler), rather than resolving HandleFunc/Handle
+// registration sites to their handler argument. Registration-site
+// resolution would need to follow a handler that's passed by name,
+// wrapped in middleware, or registered on a mux built in another
+// function - none of which resolve from a single file's syntax tree.
+// Checking the signature instead over-reports on handler-shaped helpers
+// that are never registered, but never misses a registered one. Revisit
+// once this rule has access to cross-file call-graph information.
+type pathCanonicalizationRule struct{}
+
+func (r *pathCanonicalizationRule) ID() string { return "GO-010" }
+
+func (r *pathCanonicalizationRule) Description() string {
+	return "authorization decision on r.URL.Path without path.Clean, bypassable via CONNECT"
+}
+
+func (r *pathCanonicalizationRule) Check(ctx *Context) []Finding {
+	var findings []Finding
+
+	ast.Inspect(ctx.File, func(n ast.Node) bool {
+		fn, ok := n.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || !looksLikeHandler(fn) {
+			return true
+		}
+		if hasPathClean(fn.Body) {
+			return true
+		}
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if readsURLPathUnguarded(n) {
+				line, col := ctx.Position(n)
+				findings = append(findings, Finding{
+					RuleID:   r.ID(),
+					Severity: SeverityHigh,
+					Message:  "r.URL.Path is compared/joined without path.Clean; CONNECT requests bypass net/http's path canonicalization",
+					Remediation: "call path.Clean(r.URL.Path) before comparing or joining it, or reject the CONNECT method " +
+						"in middleware ahead of routing",
+					File:   ctx.Filename,
+					Line:   line,
+					Column: col,
+				})
+			}
+			return true
+		})
+		return true
+	})
+
+	return findings
+}
+
+// looksLikeHandler is a coarse check for the http.HandlerFunc signature:
+// func(w http.ResponseWriter, r *http.Request).
+func looksLikeHandler(fn *ast.FuncDecl) bool {
+	if fn.Type.Params == nil || len(fn.Type.Params.List) != 2 {
+		return false
+	}
+	return paramTypeName(fn.Type.Params.List[1].Type) == "Request"
+}
+
+func paramTypeName(expr ast.Expr) string {
+	star, ok := expr.(*ast.StarExpr)
+	if !ok {
+		return ""
+	}
+	sel, ok := star.X.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	return sel.Sel.Name
+}
+
+func hasPathClean(body ast.Node) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if ok && sel.Sel.Name == "Clean" {
+			found = true
+		}
+		return true
+	})
+	return found
+}
+
+// readsURLPathUnguarded reports whether n is a call to a comparison/join
+// function whose arguments include a direct r.URL.Path selector, or an
+// equality comparison against one.
+func readsURLPathUnguarded(n ast.Node) bool {
+	switch v := n.(type) {
+	case *ast.CallExpr:
+		sel, ok := v.Fun.(*ast.SelectorExpr)
+		if !ok || !pathComparisonFuncs[sel.Sel.Name] {
+			return false
+		}
+		for _, arg := range v.Args {
+			if isURLPath(arg) {
+				return true
+			}
+		}
+	case *ast.BinaryExpr:
+		if v.Op.String() != "==" && v.Op.String() != "!=" {
+			return false
+		}
+		return isURLPath(v.X) || isURLPath(v.Y)
+	}
+	return false
+}
+
+// isURLPath reports whether expr is the selector r.URL.Path (for any
+// receiver name, not just literally "r").
+func isURLPath(expr ast.Expr) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Path" {
+		return false
+	}
+	inner, ok := sel.X.(*ast.SelectorExpr)
+	return ok && inner.Sel.Name == "URL"
+}
@@ -0,0 +1,16 @@
+package rules
+
+import "testing"
+
+func TestPathCanonicalizationRule(t *testing.T) {
+	ctx := parseFixture(t)
+	findings := (&pathCanonicalizationRule{}).Check(ctx)
+	lines := findingLines(findings)
+
+	if !lines[181] {
+		t.Errorf("adminHandler: expected GO-010 finding at line 181, got none")
+	}
+	if lines[286] {
+		t.Errorf("safeAdminHandler: unexpected GO-010 finding at line 286 (path.Clean applied)")
+	}
+}
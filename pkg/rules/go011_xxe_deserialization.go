@@ -0,0 +1,214 @@
+package rules
+
+import "go/ast"
+
+func init() {
+	Register(&xxeDeserializationRule{})
+}
+
+// deserializationSinks are Decode-family methods whose selector base type
+// determines the remediation. yamlV2Decode is handled separately since the
+// mitigation is "switch to yaml.v3" rather than a code change at the call
+// site.
+//
+// json.Decoder is deliberately absent: flagging Decode into interface{}
+// requires knowing the static type of the target, which this rule can't
+// determine from go/ast alone (every `dec.Decode(&x)` call has the same
+// `&<identifier>` shape regardless of x's declared type). Add it back once
+// this rule resolves identifiers with go/types instead of guessing from
+// syntax.
+var deserializationSinks = map[string]string{
+	"gob.Decoder": "gob.Decoder.Decode of network-derived data can construct arbitrary registered types",
+}
+
+// xxeDeserializationRule flags xml.Decoder used without hardening against
+// external entity expansion, and Decode calls on readers derived from a
+// network connection or request body, per GO-011.
+type xxeDeserializationRule struct{}
+
+func (r *xxeDeserializationRule) ID() string { return "GO-011" }
+
+func (r *xxeDeserializationRule) Description() string {
+	return "XXE via unhardened xml.Decoder, or unsafe deserialization of network-derived input"
+}
+
+func (r *xxeDeserializationRule) Check(ctx *Context) []Finding {
+	var findings []Finding
+
+	for _, fn := range Functions(ctx.File) {
+		taint := AnalyzeFunction(ctx, fn)
+		decoders := collectDecoderConstructors(fn.Body)
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if call, ok := n.(*ast.CallExpr); ok {
+				findings = append(findings, r.checkDecode(ctx, call, decoders, taint, fn.Body)...)
+			}
+			return true
+		})
+	}
+
+	ast.Inspect(ctx.File, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.CompositeLit); ok {
+			findings = append(findings, r.checkXMLDecoderConfig(ctx, lit)...)
+		}
+		return true
+	})
+
+	return findings
+}
+
+// collectDecoderConstructors maps each local variable assigned from a
+// `pkg.NewDecoder(...)` call within body to that call, so checkDecode can
+// resolve the idiomatic `dec := pkg.NewDecoder(x); dec.Decode(...)` split
+// back to its construction site.
+func collectDecoderConstructors(body ast.Node) map[string]*ast.CallExpr {
+	decoders := map[string]*ast.CallExpr{}
+	ast.Inspect(body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok {
+			return true
+		}
+		for i, lhs := range assign.Lhs {
+			ident, ok := lhs.(*ast.Ident)
+			if !ok || i >= len(assign.Rhs) {
+				continue
+			}
+			call, ok := assign.Rhs[i].(*ast.CallExpr)
+			if !ok {
+				continue
+			}
+			if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "NewDecoder" {
+				decoders[ident.Name] = call
+			}
+		}
+		return true
+	})
+	return decoders
+}
+
+// resolveDecoderCall returns the NewDecoder call that constructed expr:
+// either expr itself, when it's the inlined `pkg.NewDecoder(x).Decode(...)`
+// form, or the call found at expr's declaration when expr is a bare
+// identifier referring to a decoder built a few lines earlier.
+func resolveDecoderCall(expr ast.Expr, decoders map[string]*ast.CallExpr) *ast.CallExpr {
+	switch v := expr.(type) {
+	case *ast.CallExpr:
+		return v
+	case *ast.Ident:
+		return decoders[v.Name]
+	}
+	return nil
+}
+
+// hasStrictTrueAssign reports whether body contains `<varName>.Strict =
+// true`, the assignment-form equivalent of the `xml.Decoder{Strict: true}`
+// composite literal safe pattern, for decoders built via a named local
+// variable instead of a single inlined expression.
+func hasStrictTrueAssign(body ast.Node, varName string) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok {
+			return true
+		}
+		for i, lhs := range assign.Lhs {
+			sel, ok := lhs.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "Strict" {
+				continue
+			}
+			ident, ok := sel.X.(*ast.Ident)
+			if !ok || ident.Name != varName || i >= len(assign.Rhs) {
+				continue
+			}
+			if val, ok := assign.Rhs[i].(*ast.Ident); ok && val.Name == "true" {
+				found = true
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// checkDecode flags Decode() calls (xml, json, gob, yaml.v2) whose receiver
+// or argument is built from tainted input, including through a wrapping
+// reader like bufio.NewReader(r.Body).
+func (r *xxeDeserializationRule) checkDecode(ctx *Context, call *ast.CallExpr, decoders map[string]*ast.CallExpr, taint *FunctionTaint, body ast.Node) []Finding {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Decode" {
+		return nil
+	}
+
+	newDecoderCall := resolveDecoderCall(sel.X, decoders)
+	if newDecoderCall == nil {
+		return nil
+	}
+	newDecoderSel, ok := newDecoderCall.Fun.(*ast.SelectorExpr)
+	if !ok || newDecoderSel.Sel.Name != "NewDecoder" || len(newDecoderCall.Args) == 0 {
+		return nil
+	}
+	if !taint.IsTainted(newDecoderCall.Args[0]) {
+		return nil
+	}
+
+	pkgIdent, ok := newDecoderSel.X.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+
+	switch pkgIdent.Name {
+	case "xml":
+		if decoderVar, ok := sel.X.(*ast.Ident); ok && hasStrictTrueAssign(body, decoderVar.Name) {
+			return nil
+		}
+		return []Finding{r.finding(ctx, call, SeverityHigh,
+			"xml.NewDecoder reads network-derived input without disabling external entities",
+			"set d.Strict = true and install a CharsetReader that rejects external entities, or reject DOCTYPE declarations before parsing")}
+	case "yaml":
+		return []Finding{r.finding(ctx, call, SeverityHigh,
+			"gopkg.in/yaml.v2 is vulnerable to billion-laughs style expansion on network-derived input",
+			"switch to yaml.v3, which bounds alias expansion, or wrap the reader in io.LimitReader")}
+	case "gob":
+		return []Finding{r.finding(ctx, call, SeverityMedium,
+			deserializationSinks["gob.Decoder"], "decode into a concrete, minimal target type rather than a generic container")}
+	}
+	return nil
+}
+
+// checkXMLDecoderConfig flags xml.Decoder literals that leave Strict at its
+// zero value (false) explicitly, which is itself a signal the author
+// considered and disabled the strict-mode safeguard.
+func (r *xxeDeserializationRule) checkXMLDecoderConfig(ctx *Context, lit *ast.CompositeLit) []Finding {
+	sel, ok := lit.Type.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Decoder" {
+		return nil
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok || key.Name != "Strict" {
+			continue
+		}
+		if val, ok := kv.Value.(*ast.Ident); ok && val.Name == "false" {
+			return []Finding{r.finding(ctx, lit, SeverityHigh,
+				"xml.Decoder.Strict is explicitly disabled",
+				"remove the override, or if non-strict parsing is required, install a CharsetReader that rejects external entities")}
+		}
+	}
+	return nil
+}
+
+func (r *xxeDeserializationRule) finding(ctx *Context, n ast.Node, sev Severity, message, remediation string) Finding {
+	line, col := ctx.Position(n)
+	return Finding{
+		RuleID:      r.ID(),
+		Severity:    sev,
+		Message:     message,
+		Remediation: remediation,
+		File:        ctx.Filename,
+		Line:        line,
+		Column:      col,
+	}
+}
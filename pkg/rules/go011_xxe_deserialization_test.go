@@ -0,0 +1,19 @@
+package rules
+
+import "testing"
+
+func TestXXEDeserializationRule(t *testing.T) {
+	ctx := parseFixture(t)
+	findings := (&xxeDeserializationRule{}).Check(ctx)
+	lines := findingLines(findings)
+
+	for _, want := range []int{212, 222, 231} {
+		if !lines[want] {
+			t.Errorf("expected GO-011 finding at line %d, got none", want)
+		}
+	}
+
+	if lines[308] {
+		t.Errorf("safeParseUploadedXML: unexpected GO-011 finding at line 308 (dec.Strict = true)")
+	}
+}
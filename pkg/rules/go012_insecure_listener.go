@@ -0,0 +1,357 @@
+package rules
+
+import (
+	"go/ast"
+	"net"
+	"strings"
+)
+
+func init() {
+	Register(&insecureListenerRule{})
+}
+
+// listenSinks are calls that bind a listening socket, keyed by selector
+// name, mapped to the argument index carrying the address string.
+//
+// net.ListenTCP isn't here: its laddr argument is a *net.TCPAddr, not a
+// string literal, so it needs its own address-shape handling in
+// checkListenTCP rather than a BasicLit lookup at a fixed index.
+var listenSinks = map[string]int{
+	"Listen":         1, // net.Listen(network, address)
+	"ListenPacket":   1, // net.ListenPacket(network, address)
+	"ListenAndServe": 0, // http.ListenAndServe(addr, handler)
+}
+
+// tcpAddrArgIndex is the laddr position in net.ListenTCP(network, laddr).
+const tcpAddrArgIndex = 1
+
+// loopbackHosts are addresses considered safely non-public.
+var loopbackHosts = map[string]bool{
+	"127.0.0.1": true,
+	"::1":       true,
+	"localhost": true,
+}
+
+// authHeaderNames are the header/cookie hints used to guess whether a
+// handler chain performs authentication, per the ListenAndServeTLS
+// recommendation.
+var authHeaderNames = []string{"Authorization", "Set-Cookie", "session"}
+
+// insecureListenerRule flags listeners bound to all interfaces (gosec G102),
+// and plaintext http.ListenAndServe when the handler chain looks like it
+// performs authentication, per GO-012.
+type insecureListenerRule struct{}
+
+func (r *insecureListenerRule) ID() string { return "GO-012" }
+
+func (r *insecureListenerRule) Description() string {
+	return "listener bound to all interfaces, or unauthenticated plaintext HTTP serving an authenticated handler chain"
+}
+
+func (r *insecureListenerRule) Check(ctx *Context) []Finding {
+	var findings []Finding
+
+	hasAuth := r.fileLooksAuthenticated(ctx)
+
+	for _, fn := range Functions(ctx.File) {
+		servers := collectHTTPServerConstructors(fn.Body)
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "ListenAndServe" || len(call.Args) != 0 {
+				return true
+			}
+			findings = append(findings, r.checkServerListenAndServe(ctx, call, sel, servers, hasAuth)...)
+			return true
+		})
+	}
+
+	ast.Inspect(ctx.File, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if sel.Sel.Name == "ListenTCP" {
+			findings = append(findings, r.checkListenTCP(ctx, call)...)
+			return true
+		}
+
+		argIndex, known := listenSinks[sel.Sel.Name]
+		if !known || argIndex >= len(call.Args) {
+			return true
+		}
+
+		lit, ok := call.Args[argIndex].(*ast.BasicLit)
+		if !ok {
+			return true
+		}
+		addr := trimQuotes(lit.Value)
+
+		if r.isUnix(addr) || r.isLoopback(addr) {
+			return true
+		}
+
+		if r.bindsAllInterfaces(addr) {
+			findings = append(findings, r.finding(ctx, call,
+				"listener binds to all interfaces: "+lit.Value,
+				"bind to a specific loopback or private address (127.0.0.1, ::1) or a unix socket unless the service must be reachable externally"))
+			return true
+		}
+
+		if sel.Sel.Name == "ListenAndServe" && hasAuth {
+			findings = append(findings, r.finding(ctx, call,
+				"http.ListenAndServe serves an authenticated handler chain over plaintext HTTP",
+				"use ListenAndServeTLS with a valid certificate so Authorization headers and session cookies aren't sent in cleartext"))
+		}
+		return true
+	})
+
+	return findings
+}
+
+// checkListenTCP flags net.ListenTCP(network, laddr) calls whose laddr
+// resolves to a host binding all interfaces: a nil laddr, or a
+// &net.TCPAddr{...} literal with IP unset or set to a wildcard address.
+func (r *insecureListenerRule) checkListenTCP(ctx *Context, call *ast.CallExpr) []Finding {
+	if tcpAddrArgIndex >= len(call.Args) {
+		return nil
+	}
+	host, ok := r.resolveTCPAddrHost(call.Args[tcpAddrArgIndex])
+	if !ok || r.isLoopback(host) || !r.bindsAllInterfaces(host) {
+		return nil
+	}
+	return []Finding{r.finding(ctx, call,
+		"net.ListenTCP binds to all interfaces",
+		"pass a *net.TCPAddr with IP set to a specific loopback or private address (127.0.0.1, ::1) unless the service must be reachable externally")}
+}
+
+// resolveTCPAddrHost extracts the host net.ListenTCP will bind to from its
+// laddr argument. It recognizes the two shapes that appear in practice: a
+// nil laddr (net picks any available local address) and a
+// &net.TCPAddr{IP: net.ParseIP("..."), ...} literal, whose IP field binds
+// all interfaces when left unset (the zero value is nil, same as a nil
+// laddr). Any other shape - a variable, a helper's return value - can't be
+// resolved from syntax alone, so ok is false and the caller skips it.
+func (r *insecureListenerRule) resolveTCPAddrHost(arg ast.Expr) (host string, ok bool) {
+	if ident, isIdent := arg.(*ast.Ident); isIdent && ident.Name == "nil" {
+		return "", true
+	}
+
+	unary, isUnary := arg.(*ast.UnaryExpr)
+	if !isUnary || unary.Op.String() != "&" {
+		return "", false
+	}
+	lit, isLit := unary.X.(*ast.CompositeLit)
+	if !isLit {
+		return "", false
+	}
+	sel, isSel := lit.Type.(*ast.SelectorExpr)
+	if !isSel || sel.Sel.Name != "TCPAddr" {
+		return "", false
+	}
+
+	for _, elt := range lit.Elts {
+		kv, isKV := elt.(*ast.KeyValueExpr)
+		if !isKV {
+			continue
+		}
+		key, isKey := kv.Key.(*ast.Ident)
+		if !isKey || key.Name != "IP" {
+			continue
+		}
+		ipCall, isCall := kv.Value.(*ast.CallExpr)
+		if !isCall {
+			return "", false
+		}
+		ipSel, isIPSel := ipCall.Fun.(*ast.SelectorExpr)
+		if !isIPSel || ipSel.Sel.Name != "ParseIP" || len(ipCall.Args) == 0 {
+			return "", false
+		}
+		ipLit, isIPLit := ipCall.Args[0].(*ast.BasicLit)
+		if !isIPLit {
+			return "", false
+		}
+		return trimQuotes(ipLit.Value), true
+	}
+	// No IP field set: *net.TCPAddr's zero value IP is nil, which net
+	// treats the same as a nil laddr and binds all interfaces.
+	return "", true
+}
+
+// collectHTTPServerConstructors maps each local variable assigned from a
+// &http.Server{...} composite literal within body to that literal, so
+// checkServerListenAndServe can resolve the idiomatic
+// `srv := &http.Server{Addr: "..."}; srv.ListenAndServe()` split - the
+// normal way real services bind a port - back to the struct literal
+// carrying the address. Scoped to a single function body, like
+// collectDecoderConstructors in go011, so that two functions reusing the
+// same variable name don't shadow each other's literal.
+func collectHTTPServerConstructors(body ast.Node) map[string]*ast.CompositeLit {
+	servers := map[string]*ast.CompositeLit{}
+	ast.Inspect(body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok {
+			return true
+		}
+		for i, lhs := range assign.Lhs {
+			ident, ok := lhs.(*ast.Ident)
+			if !ok || i >= len(assign.Rhs) {
+				continue
+			}
+			unary, ok := assign.Rhs[i].(*ast.UnaryExpr)
+			if !ok || unary.Op.String() != "&" {
+				continue
+			}
+			lit, ok := unary.X.(*ast.CompositeLit)
+			if !ok {
+				continue
+			}
+			sel, ok := lit.Type.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "Server" {
+				continue
+			}
+			servers[ident.Name] = lit
+		}
+		return true
+	})
+	return servers
+}
+
+// checkServerListenAndServe flags the zero-arg receiver.ListenAndServe()
+// method form, resolving receiver back to the &http.Server{...} literal
+// that constructed it the same way checkListenTCP resolves a *net.TCPAddr.
+func (r *insecureListenerRule) checkServerListenAndServe(ctx *Context, call *ast.CallExpr, sel *ast.SelectorExpr, servers map[string]*ast.CompositeLit, hasAuth bool) []Finding {
+	recv, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	lit, ok := servers[recv.Name]
+	if !ok {
+		return nil
+	}
+
+	// An unresolvable Addr (e.g. cfg.Addr, built from a helper) can't be
+	// judged safe or unsafe from syntax alone, so give up on this call
+	// entirely rather than guessing - the same stance the package-level
+	// http.ListenAndServe(addr, handler) case takes when addr isn't a
+	// BasicLit.
+	addr, resolved := r.httpServerAddr(lit)
+	if !resolved {
+		return nil
+	}
+	if r.isUnix(addr) || r.isLoopback(addr) {
+		return nil
+	}
+	if r.bindsAllInterfaces(addr) {
+		return []Finding{r.finding(ctx, call,
+			"http.Server binds to all interfaces: "+addr,
+			"set Addr to a specific loopback or private address (127.0.0.1, ::1) or a unix socket unless the service must be reachable externally")}
+	}
+
+	if hasAuth {
+		return []Finding{r.finding(ctx, call,
+			"http.Server.ListenAndServe serves an authenticated handler chain over plaintext HTTP",
+			"use ListenAndServeTLS with a valid certificate so Authorization headers and session cookies aren't sent in cleartext")}
+	}
+	return nil
+}
+
+// httpServerAddr extracts the bind address from an &http.Server{...}
+// literal's Addr field. An absent Addr field is the zero value (""), which
+// net/http treats as ":http" and binds all interfaces, the same as an
+// explicit wildcard.
+func (r *insecureListenerRule) httpServerAddr(lit *ast.CompositeLit) (addr string, ok bool) {
+	for _, elt := range lit.Elts {
+		kv, isKV := elt.(*ast.KeyValueExpr)
+		if !isKV {
+			continue
+		}
+		key, isKey := kv.Key.(*ast.Ident)
+		if !isKey || key.Name != "Addr" {
+			continue
+		}
+		addrLit, isLit := kv.Value.(*ast.BasicLit)
+		if !isLit {
+			return "", false
+		}
+		return trimQuotes(addrLit.Value), true
+	}
+	return "", true
+}
+
+func (r *insecureListenerRule) bindsAllInterfaces(addr string) bool {
+	switch hostOf(addr) {
+	case "", "0.0.0.0", "::":
+		return true
+	}
+	return false
+}
+
+func (r *insecureListenerRule) isLoopback(addr string) bool {
+	return loopbackHosts[hostOf(addr)]
+}
+
+// hostOf extracts the host portion of a listen address, using
+// net.SplitHostPort so a bracketed IPv6 literal with an explicit port (e.g.
+// "[::1]:8080") is split correctly. addr may also arrive with no port at
+// all (a bare IP from resolveTCPAddrHost, or a bracketless "::" wildcard),
+// in which case SplitHostPort errors and addr is used as-is, stripped of
+// any enclosing brackets.
+func hostOf(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return strings.Trim(addr, "[]")
+}
+
+func (r *insecureListenerRule) isUnix(addr string) bool {
+	return strings.HasPrefix(addr, "/") || strings.HasSuffix(addr, ".sock")
+}
+
+// fileLooksAuthenticated is a coarse, file-wide heuristic: any handler that
+// reads the Authorization header or sets a session-like cookie is treated as
+// evidence the whole file's HTTP server is authenticated.
+func (r *insecureListenerRule) fileLooksAuthenticated(ctx *Context) bool {
+	found := false
+	ast.Inspect(ctx.File, func(n ast.Node) bool {
+		lit, ok := n.(*ast.BasicLit)
+		if !ok {
+			return true
+		}
+		value := trimQuotes(lit.Value)
+		for _, name := range authHeaderNames {
+			if strings.EqualFold(value, name) {
+				found = true
+			}
+		}
+		return true
+	})
+	return found
+}
+
+func (r *insecureListenerRule) finding(ctx *Context, n ast.Node, message, remediation string) Finding {
+	line, col := ctx.Position(n)
+	return Finding{
+		RuleID:      r.ID(),
+		Severity:    SeverityMedium,
+		Message:     message,
+		Remediation: remediation,
+		File:        ctx.Filename,
+		Line:        line,
+		Column:      col,
+	}
+}
+
+func trimQuotes(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '`') {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,21 @@
+package rules
+
+import "testing"
+
+func TestInsecureListenerRule(t *testing.T) {
+	ctx := parseFixture(t)
+	findings := (&insecureListenerRule{}).Check(ctx)
+	lines := findingLines(findings)
+
+	for _, want := range []int{245, 249, 316, 321, 339} {
+		if !lines[want] {
+			t.Errorf("expected GO-012 finding at line %d, got none", want)
+		}
+	}
+
+	for _, safe := range []int{299, 300, 326, 346, 352, 361} {
+		if lines[safe] {
+			t.Errorf("unexpected GO-012 finding at line %d", safe)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Package rules implements narsil-mcp's static analysis rule set.
+//
+// Each rule walks the AST of a single Go source file and reports Findings for
+// patterns it recognizes as security-relevant. Rules are intentionally
+// heuristic (regex/AST pattern matching, not full dataflow analysis) so they
+// stay fast enough to run on every file in an MCP tool call.
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// Severity ranks how urgently a Finding should be addressed.
+type Severity string
+
+const (
+	SeverityCritical Severity = "CRITICAL"
+	SeverityHigh     Severity = "HIGH"
+	SeverityMedium   Severity = "MEDIUM"
+	SeverityLow      Severity = "LOW"
+)
+
+// Finding is a single reported rule violation, ready to be rendered to a user
+// or returned from an MCP tool call.
+type Finding struct {
+	RuleID      string
+	Severity    Severity
+	Message     string
+	Remediation string
+	File        string
+	Line        int
+	Column      int
+}
+
+// Context carries everything a Rule needs to inspect one file.
+type Context struct {
+	Fset     *token.FileSet
+	File     *ast.File
+	Filename string
+}
+
+// Position returns the file:line:column for an AST node, for use in Findings.
+func (c *Context) Position(n ast.Node) (line, column int) {
+	pos := c.Fset.Position(n.Pos())
+	return pos.Line, pos.Column
+}
+
+// Rule inspects a single file and reports the violations it finds.
+type Rule interface {
+	// ID is the stable identifier shown to users, e.g. "GO-006".
+	ID() string
+	// Description is a one-line summary of what the rule detects.
+	Description() string
+	// Check runs the rule against ctx and returns any Findings.
+	Check(ctx *Context) []Finding
+}
+
+var registry []Rule
+
+// Register adds a Rule to the default set run by the scanner. Rules register
+// themselves from an init func in their own file.
+func Register(r Rule) {
+	registry = append(registry, r)
+}
+
+// All returns every registered Rule, in registration order.
+func All() []Rule {
+	return registry
+}
@@ -0,0 +1,238 @@
+package rules
+
+import "go/ast"
+
+// requestFieldsAndMethods are *http.Request field/method names whose value
+// is attacker-controlled, since they surface data straight from the raw
+// request. Matching the bare name isn't enough - see typeTag/exprTag below -
+// since e.g. a cache's Get(key) or an unrelated struct's Header field shares
+// a name with these.
+var requestFieldsAndMethods = map[string]bool{
+	"FormValue":     true, // r.FormValue
+	"PostFormValue": true, // r.PostFormValue
+	"URL":           true, // r.URL
+	"Body":          true, // r.Body
+	"Header":        true, // r.Header
+}
+
+// taintedFuncs are bare function identifiers considered attacker-controlled.
+var taintedFuncs = map[string]bool{
+	"Getenv": true, // os.Getenv
+}
+
+// isNetConnParam reports whether a function parameter's declared type is
+// net.Conn - a raw network connection is attacker-reachable input even
+// though it isn't read through any of the HTTP-request accessors above.
+func isNetConnParam(expr ast.Expr) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && pkg.Name == "net" && sel.Sel.Name == "Conn"
+}
+
+// typeTag identifies the narrow set of net/http and net/url types taint
+// analysis tracks through parameters and locals, just enough to tell
+// url.Values.Get/http.Header.Get apart from an unrelated type's
+// same-named Get method - the same class of bug resolveImportPath already
+// fixes for GO-006/GO-008.
+type typeTag int
+
+const (
+	tagNone typeTag = iota
+	tagHTTPRequest
+	tagHTTPHeader
+	tagURL
+	tagURLValues
+)
+
+// FunctionTaint tracks which local identifiers within a single function are
+// transitively derived from attacker-controlled input, so rules can follow
+// taint through intermediate variables (e.g. `u, _ := url.Parse(tainted)`
+// then `u.String()`, or `buffered := bufio.NewReader(r.Body)`), not just
+// single-expression chains. It also tracks a small set of net/http/net/url
+// types through those same locals, so the request-derived selectors above
+// only fire on an actual *http.Request/http.Header/url.Values receiver.
+type FunctionTaint struct {
+	ctx    *Context
+	locals map[string]bool
+	types  map[string]typeTag
+}
+
+// AnalyzeFunction builds a FunctionTaint for fn, seeding it from parameters
+// that are themselves a taint source (net.Conn) or a tracked net/http type,
+// then propagating taint and type tags through local assignments to a fixed
+// point so chained assignments (`a := tainted; b := a`, `h := r.Header`)
+// are also caught.
+//
+// Both locals and types are set on a first-writer-wins basis - once a local
+// is marked tainted or tagged with a type, a later assignment never changes
+// it - so each identifier can flip at most once and the loop is guaranteed
+// to converge. Letting a tag be overwritten by a later, different tag opens
+// the door to two variables whose tags depend on each other flipping back
+// and forth forever (e.g. `a := r.URL; b := a.Query(); a = b`), which never
+// reaches a fixed point.
+func AnalyzeFunction(ctx *Context, fn *ast.FuncDecl) *FunctionTaint {
+	ft := &FunctionTaint{ctx: ctx, locals: map[string]bool{}, types: map[string]typeTag{}}
+
+	if fn.Type.Params != nil {
+		for _, field := range fn.Type.Params.List {
+			tag := ft.paramTag(field.Type)
+			conn := isNetConnParam(field.Type)
+			if tag == tagNone && !conn {
+				continue
+			}
+			for _, name := range field.Names {
+				if tag != tagNone {
+					ft.types[name.Name] = tag
+				}
+				if conn {
+					ft.locals[name.Name] = true
+				}
+			}
+		}
+	}
+
+	if fn.Body == nil {
+		return ft
+	}
+
+	for {
+		changed := false
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			assign, ok := n.(*ast.AssignStmt)
+			if !ok {
+				return true
+			}
+			for i, lhs := range assign.Lhs {
+				ident, ok := lhs.(*ast.Ident)
+				if !ok || ident.Name == "_" || i >= len(assign.Rhs) {
+					continue
+				}
+				if !ft.locals[ident.Name] && ft.IsTainted(assign.Rhs[i]) {
+					ft.locals[ident.Name] = true
+					changed = true
+				}
+				if tag := ft.exprTag(assign.Rhs[i]); tag != tagNone && ft.types[ident.Name] == tagNone {
+					ft.types[ident.Name] = tag
+					changed = true
+				}
+			}
+			return true
+		})
+		if !changed {
+			break
+		}
+	}
+
+	return ft
+}
+
+// paramTag resolves a parameter's declared type to a typeTag: *http.Request,
+// http.Header, or url.Values. Anything else tags as tagNone.
+func (ft *FunctionTaint) paramTag(expr ast.Expr) typeTag {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		if resolvesToQualifiedType(ft.ctx, star.X, "net/http", "Request") {
+			return tagHTTPRequest
+		}
+		return tagNone
+	}
+	switch {
+	case resolvesToQualifiedType(ft.ctx, expr, "net/http", "Header"):
+		return tagHTTPHeader
+	case resolvesToQualifiedType(ft.ctx, expr, "net/url", "Values"):
+		return tagURLValues
+	}
+	return tagNone
+}
+
+// resolvesToQualifiedType reports whether expr is the selector pkg.Name,
+// with pkg's import resolved to importPath.
+func resolvesToQualifiedType(ctx *Context, expr ast.Expr, importPath, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != name {
+		return false
+	}
+	pkgIdent, ok := sel.X.(*ast.Ident)
+	return ok && resolveImportPath(ctx.File, pkgIdent.Name) == importPath
+}
+
+// exprTag resolves the tracked type of an arbitrary expression: a local or
+// parameter already tagged, or one of the field/method accesses that derive
+// a tracked type from another one (r.Header is http.Header, r.URL is
+// url.URL, u.Query() is url.Values).
+func (ft *FunctionTaint) exprTag(expr ast.Expr) typeTag {
+	switch v := expr.(type) {
+	case *ast.Ident:
+		return ft.types[v.Name]
+	case *ast.SelectorExpr:
+		switch {
+		case v.Sel.Name == "Header" && ft.exprTag(v.X) == tagHTTPRequest:
+			return tagHTTPHeader
+		case v.Sel.Name == "URL" && ft.exprTag(v.X) == tagHTTPRequest:
+			return tagURL
+		}
+	case *ast.CallExpr:
+		if sel, ok := v.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Query" && ft.exprTag(sel.X) == tagURL {
+			return tagURLValues
+		}
+	}
+	return tagNone
+}
+
+// isDirectlyTainted reports whether n is itself a known taint source: a
+// request-derived field/method (e.g. r.Body or r.FormValue) or Get call
+// (values.Get/header.Get) whose receiver actually resolves to the matching
+// net/http or net/url type, os.Args, or a bare tainted function call
+// (os.Getenv).
+func (ft *FunctionTaint) isDirectlyTainted(n ast.Node) bool {
+	switch v := n.(type) {
+	case *ast.SelectorExpr:
+		if requestFieldsAndMethods[v.Sel.Name] && ft.exprTag(v.X) == tagHTTPRequest {
+			return true
+		}
+		if v.Sel.Name == "Get" {
+			if tag := ft.exprTag(v.X); tag == tagHTTPHeader || tag == tagURLValues {
+				return true
+			}
+		}
+		pkg, ok := v.X.(*ast.Ident)
+		return ok && pkg.Name == "os" && v.Sel.Name == "Args"
+	case *ast.CallExpr:
+		ident, ok := v.Fun.(*ast.Ident)
+		return ok && taintedFuncs[ident.Name]
+	}
+	return false
+}
+
+// IsTainted reports whether expr is derived from attacker-controlled input:
+// directly, through a known taint source anywhere in its subtree, or
+// transitively through a local variable already known to be tainted.
+func (ft *FunctionTaint) IsTainted(expr ast.Expr) bool {
+	tainted := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if ft.isDirectlyTainted(n) {
+			tainted = true
+			return false
+		}
+		if ident, ok := n.(*ast.Ident); ok && ft.locals[ident.Name] {
+			tainted = true
+			return false
+		}
+		return true
+	})
+	return tainted
+}
+
+// Functions returns every top-level function declaration in file that has a
+// body, for rules that need per-function taint analysis.
+func Functions(file *ast.File) []*ast.FuncDecl {
+	var fns []*ast.FuncDecl
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+			fns = append(fns, fn)
+		}
+	}
+	return fns
+}
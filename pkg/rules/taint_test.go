@@ -0,0 +1,46 @@
+package rules
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+	"time"
+)
+
+// TestAnalyzeFunction_ConvergesOnReassignedTypeTag guards against a local
+// being assigned a second, different tracked type after it already has one
+// (`a := r.URL; b := a.Query(); a = b`) sending the type-tag fixed point
+// into an infinite back-and-forth between a and b's tags instead of
+// converging - AnalyzeFunction must return promptly either way.
+func TestAnalyzeFunction_ConvergesOnReassignedTypeTag(t *testing.T) {
+	const src = `package main
+
+import "net/http"
+
+func viaReassign(r *http.Request) {
+	a := r.URL
+	b := a.Query()
+	a = b
+	_ = a
+}
+`
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "reassign.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("parse source: %v", err)
+	}
+	ctx := &Context{Fset: fset, File: file, Filename: "reassign.go"}
+	fn := Functions(file)[0]
+
+	done := make(chan struct{})
+	go func() {
+		AnalyzeFunction(ctx, fn)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("AnalyzeFunction did not converge: reassigning a local to a different tracked type looped forever")
+	}
+}
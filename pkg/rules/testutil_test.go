@@ -0,0 +1,32 @@
+package rules
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+// fixturePath is the shared scanner fixture exercising every rule's
+// BAD/SAFE example patterns.
+const fixturePath = "../../test-fixtures/security/testdata/vulnerable.go"
+
+// parseFixture parses fixturePath into a Context ready for a single rule's
+// Check, so each rule's test can run independently of the others.
+func parseFixture(t *testing.T) *Context {
+	t.Helper()
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, fixturePath, nil, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	return &Context{Fset: fset, File: file, Filename: fixturePath}
+}
+
+// findingLines returns the set of source lines a rule reported a Finding on.
+func findingLines(findings []Finding) map[int]bool {
+	lines := map[int]bool{}
+	for _, f := range findings {
+		lines[f.Line] = true
+	}
+	return lines
+}
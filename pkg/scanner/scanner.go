@@ -0,0 +1,70 @@
+// Package scanner drives narsil-mcp's rule set over Go source files and
+// collects the resulting Findings.
+package scanner
+
+import (
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/doctordisrespect/narsil-mcp/pkg/rules"
+)
+
+// ScanFile parses the Go source at path and runs every registered rule
+// against it, returning all Findings in rule-registration order.
+func ScanFile(path string) ([]rules.Finding, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := &rules.Context{Fset: fset, File: file, Filename: path}
+
+	var findings []rules.Finding
+	for _, rule := range rules.All() {
+		findings = append(findings, rule.Check(ctx)...)
+	}
+	return findings, nil
+}
+
+// ScanDir walks root and runs ScanFile over every .go file it finds,
+// skipping vendor and testdata directories (vendored dependencies aren't
+// this project's code, and testdata is by convention fixtures rather than
+// real source). A single file that fails to parse, or a subdirectory that
+// fails to read (e.g. a permission-denied subdirectory), is skipped rather
+// than aborting the whole walk, so one bad entry doesn't hide findings in
+// the rest of the tree - but root itself failing to open is reported, since
+// that means nothing was scanned at all.
+func ScanDir(root string) ([]rules.Finding, error) {
+	var findings []rules.Finding
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == root {
+				return err
+			}
+			return nil
+		}
+		if d.IsDir() {
+			if path != root && (d.Name() == "vendor" || d.Name() == "testdata") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(d.Name(), ".go") {
+			return nil
+		}
+
+		fileFindings, err := ScanFile(path)
+		if err != nil {
+			return nil
+		}
+		findings = append(findings, fileFindings...)
+		return nil
+	})
+
+	return findings, err
+}
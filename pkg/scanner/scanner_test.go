@@ -0,0 +1,82 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestScanDir(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFixture(t, dir, "main.go", `package main
+
+import "os"
+
+func writeConfig(path string, data []byte) {
+	os.WriteFile(path, data, 0644) // BAD: world-readable file
+}
+`)
+
+	vendorDir := filepath.Join(dir, "vendor", "example.com/dep")
+	if err := os.MkdirAll(vendorDir, 0755); err != nil {
+		t.Fatalf("mkdir vendor: %v", err)
+	}
+	writeFixture(t, vendorDir, "dep.go", `package dep
+
+import "os"
+
+func init() {
+	os.WriteFile("dep.conf", nil, 0644) // BAD, but vendored: must not be scanned
+}
+`)
+
+	findings, err := ScanDir(dir)
+	if err != nil {
+		t.Fatalf("ScanDir: %v", err)
+	}
+
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding (vendor/ excluded), got %d: %v", len(findings), findings)
+	}
+	if findings[0].RuleID != "GO-007" {
+		t.Errorf("expected GO-007 finding, got %s", findings[0].RuleID)
+	}
+}
+
+func TestScanDir_RootNamedVendorIsStillScanned(t *testing.T) {
+	dir := t.TempDir()
+	vendorRoot := filepath.Join(dir, "vendor")
+	if err := os.MkdirAll(vendorRoot, 0755); err != nil {
+		t.Fatalf("mkdir vendor: %v", err)
+	}
+	writeFixture(t, vendorRoot, "main.go", `package main
+
+import "os"
+
+func writeConfig(path string, data []byte) {
+	os.WriteFile(path, data, 0644) // BAD: world-readable file
+}
+`)
+
+	findings, err := ScanDir(vendorRoot)
+	if err != nil {
+		t.Fatalf("ScanDir: %v", err)
+	}
+	if len(findings) != 1 {
+		t.Errorf("expected 1 finding when the scan root itself is named vendor, got %d", len(findings))
+	}
+}
+
+func TestScanDir_NonexistentRootReturnsError(t *testing.T) {
+	if _, err := ScanDir(filepath.Join(t.TempDir(), "does-not-exist")); err == nil {
+		t.Error("expected an error for a nonexistent root, got nil")
+	}
+}
+
+func writeFixture(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatalf("write fixture %s: %v", name, err)
+	}
+}
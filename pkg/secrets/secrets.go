@@ -0,0 +1,119 @@
+// Package secrets scans non-Go files in a repository for leaked
+// credentials: .netrc and .env files, and htpasswd-format files using a weak
+// hash scheme.
+package secrets
+
+import (
+	"bufio"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Finding is a single leaked-secret hit outside Go source.
+type Finding struct {
+	Kind    string // "netrc", "env", or "htpasswd"
+	Path    string
+	Line    int
+	Message string
+}
+
+// ScanRepo walks root looking for .netrc, .env, and htpasswd-format files
+// and returns a Finding for each credential or weak hash it recognizes.
+// vendor and testdata directories are skipped (vendored dependencies and
+// test fixtures aren't the repo's own secrets), and an unreadable
+// subdirectory is skipped rather than aborting the walk - but root itself
+// failing to open is reported, since that means nothing was scanned at all.
+func ScanRepo(root string) ([]Finding, error) {
+	var findings []Finding
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == root {
+				return err
+			}
+			return nil
+		}
+		if d.IsDir() {
+			if path != root && (d.Name() == "vendor" || d.Name() == "testdata") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		switch {
+		case d.Name() == ".netrc":
+			findings = append(findings, scanNetrc(path)...)
+		case d.Name() == ".env" || strings.HasPrefix(d.Name(), ".env."):
+			findings = append(findings, scanEnvFile(path)...)
+		case strings.Contains(d.Name(), "htpasswd"):
+			findings = append(findings, scanHtpasswd(path)...)
+		}
+		return nil
+	})
+
+	return findings, err
+}
+
+func scanNetrc(path string) []Finding {
+	return eachLine(path, func(line string, n int) *Finding {
+		if strings.Contains(line, "password") {
+			return &Finding{Kind: "netrc", Path: path, Line: n, Message: ".netrc stores credentials in plaintext"}
+		}
+		return nil
+	})
+}
+
+// envSecretKey matches .env assignments whose key name suggests a secret,
+// e.g. API_KEY=..., DB_PASSWORD=....
+var envSecretKey = regexp.MustCompile(`(?i)^[A-Z0-9_]*(PASSWORD|SECRET|TOKEN|API_KEY)[A-Z0-9_]*=\S+`)
+
+func scanEnvFile(path string) []Finding {
+	return eachLine(path, func(line string, n int) *Finding {
+		if envSecretKey.MatchString(strings.TrimSpace(line)) {
+			return &Finding{Kind: "env", Path: path, Line: n, Message: ".env file commits a secret-looking value to the repo"}
+		}
+		return nil
+	})
+}
+
+// scanHtpasswd parses `user:hash` entries and flags hash schemes weaker than
+// bcrypt: APR1-MD5 (`$apr1$`), plain crypt (`$1$`), and unsalted SHA1
+// (`{SHA}` prefix).
+func scanHtpasswd(path string) []Finding {
+	return eachLine(path, func(line string, n int) *Finding {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			return nil
+		}
+		hash := parts[1]
+		switch {
+		case strings.HasPrefix(hash, "$apr1$"):
+			return &Finding{Kind: "htpasswd", Path: path, Line: n, Message: "APR1-MD5 htpasswd entry; use bcrypt ($2y$)"}
+		case strings.HasPrefix(hash, "{SHA}"):
+			return &Finding{Kind: "htpasswd", Path: path, Line: n, Message: "unsalted SHA1 htpasswd entry; use bcrypt ($2y$)"}
+		case strings.HasPrefix(hash, "$1$"):
+			return &Finding{Kind: "htpasswd", Path: path, Line: n, Message: "crypt(3) htpasswd entry; use bcrypt ($2y$)"}
+		}
+		return nil
+	})
+}
+
+func eachLine(path string, check func(line string, n int) *Finding) []Finding {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var findings []Finding
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		if finding := check(scanner.Text(), n); finding != nil {
+			findings = append(findings, *finding)
+		}
+	}
+	return findings
+}
@@ -0,0 +1,89 @@
+package secrets
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestScanRepo(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFixture(t, dir, ".netrc", "machine example.com\nlogin bob\npassword hunter2\n")
+	writeFixture(t, dir, ".env", "DEBUG=true\nDB_PASSWORD=hunter2\n")
+	writeFixture(t, dir, "htpasswd", "alice:$apr1$abcd1234$efghijklmnopqrstuv\nbob:$2y$10$abcdefghijklmnopqrstuv\n")
+
+	findings, err := ScanRepo(dir)
+	if err != nil {
+		t.Fatalf("ScanRepo: %v", err)
+	}
+
+	kinds := map[string]int{}
+	for _, f := range findings {
+		kinds[f.Kind]++
+	}
+
+	if kinds["netrc"] != 1 {
+		t.Errorf("expected 1 netrc finding, got %d", kinds["netrc"])
+	}
+	if kinds["env"] != 1 {
+		t.Errorf("expected 1 env finding, got %d", kinds["env"])
+	}
+	if kinds["htpasswd"] != 1 {
+		t.Errorf("expected 1 htpasswd finding (apr1 weak, bcrypt entry not flagged), got %d", kinds["htpasswd"])
+	}
+}
+
+func TestScanRepo_SkipsVendorAndTestdata(t *testing.T) {
+	dir := t.TempDir()
+
+	vendorDir := filepath.Join(dir, "vendor", "example.com/dep")
+	if err := os.MkdirAll(vendorDir, 0755); err != nil {
+		t.Fatalf("mkdir vendor: %v", err)
+	}
+	writeFixture(t, vendorDir, ".env", "API_KEY=abc123\n")
+
+	testdataDir := filepath.Join(dir, "testdata")
+	if err := os.MkdirAll(testdataDir, 0755); err != nil {
+		t.Fatalf("mkdir testdata: %v", err)
+	}
+	writeFixture(t, testdataDir, ".env", "API_KEY=abc123\n")
+
+	findings, err := ScanRepo(dir)
+	if err != nil {
+		t.Fatalf("ScanRepo: %v", err)
+	}
+	if len(findings) != 0 {
+		t.Errorf("expected vendor/testdata to be skipped, got findings: %v", findings)
+	}
+}
+
+func TestScanRepo_RootNamedTestdataIsStillScanned(t *testing.T) {
+	dir := t.TempDir()
+	testdataRoot := filepath.Join(dir, "testdata")
+	if err := os.MkdirAll(testdataRoot, 0755); err != nil {
+		t.Fatalf("mkdir testdata: %v", err)
+	}
+	writeFixture(t, testdataRoot, ".env", "API_KEY=abc123\n")
+
+	findings, err := ScanRepo(testdataRoot)
+	if err != nil {
+		t.Fatalf("ScanRepo: %v", err)
+	}
+	if len(findings) != 1 {
+		t.Errorf("expected 1 finding when the scan root itself is named testdata, got %d", len(findings))
+	}
+}
+
+func TestScanRepo_NonexistentRootReturnsError(t *testing.T) {
+	if _, err := ScanRepo(filepath.Join(t.TempDir(), "does-not-exist")); err == nil {
+		t.Error("expected an error for a nonexistent root, got nil")
+	}
+}
+
+func writeFixture(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatalf("write fixture %s: %v", name, err)
+	}
+}